@@ -0,0 +1,392 @@
+package bst
+
+import (
+	"cmp"
+	"iter"
+)
+
+// AVLTree is a self-balancing binary search tree mapping keys of type K to values of type V
+// After every insert and delete the heights of the two subtrees of any node differ by at most one,
+// which keeps the height below 1.45*log2(n+2) whatever order the keys arrive in.
+// Search, Insert, Delete, Min and Max are therefore O(log n) in the worst case, where a plain
+// Node degrades to O(n) on sorted input
+type AVLTree[K, V any] struct {
+	root    *node[K, V]
+	size    int              // Number of keys in the tree
+	compare func(a, b K) int // Orders the keys
+}
+
+// NewAVL creates a new, empty AVL tree whose keys are ordered by cmp.Compare
+// Parameters:
+//   - opts: Options such as WithComparator
+func NewAVL[K cmp.Ordered, V any](opts ...Option[K]) *AVLTree[K, V] {
+	return NewAVLFunc[K, V](cmp.Compare[K], opts...)
+}
+
+// NewAVLFunc creates a new, empty AVL tree whose keys are ordered by compare, for key types
+// such as structs that have no natural order
+// It panics if compare is nil
+// Parameters:
+//   - compare: Returns a negative number if a < b, zero if a == b and a positive number if a > b
+//   - opts: Options such as WithComparator
+func NewAVLFunc[K, V any](compare func(a, b K) int, opts ...Option[K]) *AVLTree[K, V] {
+	c := buildConfig(compare, opts)
+	return &AVLTree[K, V]{compare: c.compare}
+}
+
+// Insert stores the value for the key, replacing the value if the key is already present,
+// and rebalances the tree on the way back up
+// Time complexity: O(log n)
+// Returns:
+//   - bool: True if the key was new, false if an existing value was replaced
+func (t *AVLTree[K, V]) Insert(key K, value V) bool {
+	var inserted bool
+	t.root, inserted = t.root.insertAVL(key, value, t.compare)
+	if inserted {
+		t.size++
+	}
+	return inserted
+}
+
+// Delete removes the key and its value from the tree, and rebalances the tree on the way back up
+// A node with two children takes the key and value of its in-order successor, which is removed
+// from the right subtree instead
+// Time complexity: O(log n)
+// Returns:
+//   - bool: True if the key was found and removed
+func (t *AVLTree[K, V]) Delete(key K) bool {
+	var deleted bool
+	t.root, deleted = t.root.deleteAVL(key, t.compare)
+	if deleted {
+		t.size--
+	}
+	return deleted
+}
+
+// Search checks if the key exists in the tree
+// Time complexity: O(log n)
+func (t *AVLTree[K, V]) Search(key K) bool {
+	return t.root.find(key, t.compare) != nil
+}
+
+// Get returns the value stored for the key
+// Time complexity: O(log n)
+// Returns:
+//   - V: The stored value, or the zero value if the key is absent
+//   - bool: True if the key was found
+func (t *AVLTree[K, V]) Get(key K) (V, bool) {
+	if n := t.root.find(key, t.compare); n != nil {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Min returns the smallest key in the tree
+// Time complexity: O(log n)
+// Returns:
+//   - K: The smallest key, or the zero value if the tree is empty
+//   - bool: True if the tree is not empty
+func (t *AVLTree[K, V]) Min() (K, bool) {
+	if t.root == nil {
+		var zero K
+		return zero, false
+	}
+	return t.root.min().key, true
+}
+
+// Max returns the largest key in the tree
+// Time complexity: O(log n)
+// Returns:
+//   - K: The largest key, or the zero value if the tree is empty
+//   - bool: True if the tree is not empty
+func (t *AVLTree[K, V]) Max() (K, bool) {
+	if t.root == nil {
+		var zero K
+		return zero, false
+	}
+	return t.root.max().key, true
+}
+
+// Len returns the number of keys in the tree
+// Time complexity: O(1)
+func (t *AVLTree[K, V]) Len() int {
+	return t.size
+}
+
+// Height returns the number of nodes on the longest path from the root to a leaf, 0 for an empty tree
+// Time complexity: O(1), since every node stores its height
+func (t *AVLTree[K, V]) Height() int {
+	return t.root.getHeight()
+}
+
+// InOrderTraversal returns the keys of the tree in sorted order
+// Time complexity: O(n)
+func (t *AVLTree[K, V]) InOrderTraversal() []K {
+	return collectKeys(t.size, t.root, inOrder)
+}
+
+// PreOrderTraversal returns the keys of the tree in pre-order (root -> left -> right)
+// Time complexity: O(n)
+func (t *AVLTree[K, V]) PreOrderTraversal() []K {
+	return collectKeys(t.size, t.root, preOrder)
+}
+
+// PostOrderTraversal returns the keys of the tree in post-order (left -> right -> root)
+// Time complexity: O(n)
+func (t *AVLTree[K, V]) PostOrderTraversal() []K {
+	return collectKeys(t.size, t.root, postOrder)
+}
+
+// LevelOrderTraversal returns the keys of the tree level by level, from the root down and from
+// left to right within each level
+// Time complexity: O(n)
+func (t *AVLTree[K, V]) LevelOrderTraversal() []K {
+	return collectKeys(t.size, t.root, levelOrder)
+}
+
+// All returns an iterator over the keys and values of the tree in sorted order
+// The tree must not be modified while iterating
+// Time complexity: O(n) for a full iteration
+func (t *AVLTree[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		inOrder(t.root, func(n *node[K, V]) bool {
+			return yield(n.key, n.value)
+		})
+	}
+}
+
+// AllFrom returns an iterator over the keys >= the given key and their values, in sorted order
+// The key need not be in the tree. The iterator is lazy: it only walks as far as the caller reads
+// The tree must not be modified while iterating
+// Time complexity: O(log n) to find the first key, then O(1) amortized per key
+func (t *AVLTree[K, V]) AllFrom(key K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.root.inOrderFrom(key, t.compare, func(n *node[K, V]) bool {
+			return yield(n.key, n.value)
+		})
+	}
+}
+
+// PreOrder returns an iterator over the keys of the tree in pre-order (root -> left -> right)
+// Time complexity: O(n) for a full iteration
+func (t *AVLTree[K, V]) PreOrder() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		preOrder(t.root, func(n *node[K, V]) bool {
+			return yield(n.key)
+		})
+	}
+}
+
+// PostOrder returns an iterator over the keys of the tree in post-order (left -> right -> root)
+// Time complexity: O(n) for a full iteration
+func (t *AVLTree[K, V]) PostOrder() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		postOrder(t.root, func(n *node[K, V]) bool {
+			return yield(n.key)
+		})
+	}
+}
+
+// LevelOrder returns an iterator over the keys of the tree level by level, from the root down and
+// from left to right within each level
+// Time complexity: O(n) for a full iteration
+func (t *AVLTree[K, V]) LevelOrder() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		levelOrder(t.root, func(n *node[K, V]) bool {
+			return yield(n.key)
+		})
+	}
+}
+
+// Floor returns the largest key less than or equal to the given key
+// Time complexity: O(log n)
+// Returns:
+//   - K: The floor, or the zero value if there is none
+//   - bool: True if a floor exists
+func (t *AVLTree[K, V]) Floor(key K) (K, bool) {
+	return t.root.closest(key, t.compare, true, true)
+}
+
+// Ceiling returns the smallest key greater than or equal to the given key
+// Time complexity: O(log n)
+// Returns:
+//   - K: The ceiling, or the zero value if there is none
+//   - bool: True if a ceiling exists
+func (t *AVLTree[K, V]) Ceiling(key K) (K, bool) {
+	return t.root.closest(key, t.compare, false, true)
+}
+
+// Predecessor returns the largest key strictly less than the given key, which need not be in the tree
+// Time complexity: O(log n)
+// Returns:
+//   - K: The predecessor, or the zero value if there is none
+//   - bool: True if a predecessor exists
+func (t *AVLTree[K, V]) Predecessor(key K) (K, bool) {
+	return t.root.closest(key, t.compare, true, false)
+}
+
+// Successor returns the smallest key strictly greater than the given key, which need not be in the tree
+// Time complexity: O(log n)
+// Returns:
+//   - K: The successor, or the zero value if there is none
+//   - bool: True if a successor exists
+func (t *AVLTree[K, V]) Successor(key K) (K, bool) {
+	return t.root.closest(key, t.compare, false, false)
+}
+
+// Rank returns the number of keys strictly less than the given key, which need not be in the tree
+// If the key is present, this is its index in sorted order
+// Time complexity: O(log n), thanks to the subtree sizes stored in every node
+func (t *AVLTree[K, V]) Rank(key K) int {
+	return t.root.rank(key, t.compare)
+}
+
+// Select returns the key at index i in sorted order, so Select(0) is the minimum
+// It is the inverse of Rank: Select(Rank(k)) == k for every key k in the tree
+// Time complexity: O(log n)
+// Returns:
+//   - K: The key at index i, or the zero value if i is out of range
+//   - bool: True if 0 <= i < Len()
+func (t *AVLTree[K, V]) Select(i int) (K, bool) {
+	if n := t.root.selectAt(i); n != nil {
+		return n.key, true
+	}
+	var zero K
+	return zero, false
+}
+
+// Range returns the keys k with lo <= k <= hi, in sorted order
+// Subtrees that lie entirely outside the range are never visited
+// Time complexity: O(log n + m) where m is the number of keys returned
+func (t *AVLTree[K, V]) Range(lo, hi K) []K {
+	keys := []K{}
+	t.root.rangeKeys(lo, hi, t.compare, &keys)
+	return keys
+}
+
+// CountRange returns the number of keys k with lo <= k <= hi
+// Time complexity: O(log n), using two rank queries
+func (t *AVLTree[K, V]) CountRange(lo, hi K) int {
+	return t.root.countRange(lo, hi, t.compare)
+}
+
+// insertAVL adds the key to the subtree and returns the new root of the rebalanced subtree
+func (n *node[K, V]) insertAVL(key K, value V, compare func(a, b K) int) (*node[K, V], bool) {
+	if n == nil {
+		return &node[K, V]{key: key, value: value, height: 1, size: 1}, true
+	}
+
+	var inserted bool
+	switch c := compare(key, n.key); {
+	case c < 0:
+		n.left, inserted = n.left.insertAVL(key, value, compare)
+	case c > 0:
+		n.right, inserted = n.right.insertAVL(key, value, compare)
+	default:
+		n.value = value // Existing key: update in place, the shape does not change
+		return n, false
+	}
+	return n.rebalance(), inserted
+}
+
+// deleteAVL removes the key from the subtree and returns the new root of the rebalanced subtree
+// Returns:
+//   - *node[K, V]: The new root of the subtree, nil if it became empty
+//   - bool: True if the key was found and removed
+func (n *node[K, V]) deleteAVL(key K, compare func(a, b K) int) (*node[K, V], bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	var deleted bool
+	switch c := compare(key, n.key); {
+	case c < 0:
+		n.left, deleted = n.left.deleteAVL(key, compare)
+	case c > 0:
+		n.right, deleted = n.right.deleteAVL(key, compare)
+	case n.left == nil:
+		return n.right, true // The remaining child, if any, is already balanced
+	case n.right == nil:
+		return n.left, true
+	default:
+		// Two children: take over the in-order successor's key and value, then delete the
+		// successor, which has no left child, from the right subtree
+		successor := n.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+		n.key, n.value = successor.key, successor.value
+		n.right, deleted = n.right.deleteAVL(successor.key, compare)
+	}
+	if !deleted {
+		return n, false // Nothing below changed
+	}
+	return n.rebalance(), true
+}
+
+// getHeight returns the height of the subtree, 0 for an empty one
+func (n *node[K, V]) getHeight() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+// balanceFactor returns the height of the left subtree minus the height of the right one
+func (n *node[K, V]) balanceFactor() int {
+	return n.left.getHeight() - n.right.getHeight()
+}
+
+// update recomputes the height and size of the node from its children
+func (n *node[K, V]) update() {
+	n.height = 1 + max(n.left.getHeight(), n.right.getHeight())
+	n.size = 1 + n.left.subtreeSize() + n.right.subtreeSize()
+}
+
+// rebalance restores the AVL property at the node after one of its subtrees changed height by one
+// and returns the new root of the subtree
+//
+//	Left-left: rotate right         Left-right: rotate the left child left, then rotate right
+//	      z              y                z               z              x
+//	     /              / \              /               /              / \
+//	    y      ->      x   z            y       ->      x      ->      y   z
+//	   /                                 \             /
+//	  x                                   x           y
+func (n *node[K, V]) rebalance() *node[K, V] {
+	n.update()
+
+	switch bf := n.balanceFactor(); {
+	case bf > 1: // Left side too tall
+		if n.left.balanceFactor() < 0 {
+			n.left = n.left.rotateLeft()
+		}
+		return n.rotateRight()
+	case bf < -1: // Right side too tall
+		if n.right.balanceFactor() > 0 {
+			n.right = n.right.rotateRight()
+		}
+		return n.rotateLeft()
+	}
+	return n
+}
+
+// rotateRight lifts the left child above the node and returns it as the new subtree root
+func (n *node[K, V]) rotateRight() *node[K, V] {
+	l := n.left
+	n.left = l.right
+	l.right = n
+	n.update()
+	l.update()
+	return l
+}
+
+// rotateLeft lifts the right child above the node and returns it as the new subtree root
+func (n *node[K, V]) rotateLeft() *node[K, V] {
+	r := n.right
+	n.right = r.left
+	r.left = n
+	n.update()
+	r.update()
+	return r
+}
@@ -0,0 +1,443 @@
+package bst
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// checkAVL verifies the key order, the stored heights and sizes and the AVL balance of every node
+func checkAVL[K, V any](t *testing.T, tree *AVLTree[K, V]) {
+	t.Helper()
+
+	// lo and hi bound the keys allowed in the subtree, nil meaning unbounded
+	var check func(n *node[K, V], lo, hi *K) int
+	check = func(n *node[K, V], lo, hi *K) int {
+		if n == nil {
+			return 0
+		}
+		if size := 1 + n.left.subtreeSize() + n.right.subtreeSize(); n.size != size {
+			t.Errorf("Node %v stores size %d, want %d", n.key, n.size, size)
+		}
+		if (lo != nil && tree.compare(n.key, *lo) <= 0) || (hi != nil && tree.compare(n.key, *hi) >= 0) {
+			t.Errorf("Key %v is outside the range allowed by its ancestors", n.key)
+		}
+		lh, rh := check(n.left, lo, &n.key), check(n.right, &n.key, hi)
+		if lh-rh > 1 || rh-lh > 1 {
+			t.Errorf("Node %v is unbalanced: left height %d, right height %d", n.key, lh, rh)
+		}
+		if h := 1 + max(lh, rh); n.height != h {
+			t.Errorf("Node %v stores height %d, want %d", n.key, n.height, h)
+		}
+		return 1 + max(lh, rh)
+	}
+	check(tree.root, nil, nil)
+}
+
+// keysOf collects the keys of a key/value sequence
+func keysOf[K, V any](seq iter.Seq2[K, V]) []K {
+	var keys []K
+	for key := range seq {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// maxAVLHeight is the largest height an AVL tree with n keys can have
+func maxAVLHeight(n int) int {
+	return int(1.4405 * math.Log2(float64(n)+2))
+}
+
+// keyOrders returns the keys 0..n-1 in the orders that stress a balanced tree, sorted ascending first
+func keyOrders(n int) []struct {
+	name string
+	keys []int
+} {
+	ascending := make([]int, n)
+	for i := range ascending {
+		ascending[i] = i
+	}
+	descending := slices.Clone(ascending)
+	slices.Reverse(descending)
+	// Zig-zag: alternately the smallest and the largest remaining key
+	zigzag := make([]int, 0, n)
+	for lo, hi := 0, n-1; lo <= hi; lo, hi = lo+1, hi-1 {
+		zigzag = append(zigzag, lo)
+		if lo != hi {
+			zigzag = append(zigzag, hi)
+		}
+	}
+	shuffled := slices.Clone(ascending)
+	rand.New(rand.NewPCG(1, 2)).Shuffle(n, func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+
+	return []struct {
+		name string
+		keys []int
+	}{
+		{"Ascending", ascending},
+		{"Descending", descending},
+		{"Zig-zag", zigzag},
+		{"Shuffled", shuffled},
+	}
+}
+
+func TestAVLHeightStaysLogarithmic(t *testing.T) {
+	const n = 10_000
+
+	tests := keyOrders(n)
+	ascending := tests[0].keys
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree := NewAVL[int, int]()
+			for i, key := range tt.keys {
+				tree.Insert(key, key*2)
+				// Check the bound as the tree grows, not only at the end
+				if size := i + 1; size&(size-1) == 0 && tree.Height() > maxAVLHeight(size) {
+					t.Fatalf("Height after %d inserts = %d, want at most %d", size, tree.Height(), maxAVLHeight(size))
+				}
+			}
+
+			if tree.Len() != n {
+				t.Errorf("Len() = %d, want %d", tree.Len(), n)
+			}
+			if tree.Height() > maxAVLHeight(n) {
+				t.Errorf("Height() = %d, want at most %d", tree.Height(), maxAVLHeight(n))
+			}
+			checkAVL(t, tree)
+			if !slices.Equal(tree.InOrderTraversal(), ascending) {
+				t.Errorf("InOrderTraversal() is not the sorted key list")
+			}
+		})
+	}
+}
+
+func TestAVLDeleteHeightStaysLogarithmic(t *testing.T) {
+	const n = 10_000
+
+	tests := keyOrders(n)
+	shuffled := tests[len(tests)-1].keys
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Build every tree from the shuffled keys, then delete in the order under test
+			tree := NewAVL[int, int]()
+			for _, key := range shuffled {
+				tree.Insert(key, key*2)
+			}
+
+			for i, key := range tt.keys {
+				if !tree.Delete(key) {
+					t.Fatalf("Delete(%d) = false, want true", key)
+				}
+				// Check the bound as the tree shrinks, not only at the end
+				if size := n - i - 1; size&(size-1) == 0 {
+					if tree.Height() > maxAVLHeight(size) {
+						t.Fatalf("Height with %d keys left = %d, want at most %d", size, tree.Height(), maxAVLHeight(size))
+					}
+					checkAVL(t, tree)
+					if tree.Len() != size {
+						t.Fatalf("Len() = %d, want %d", tree.Len(), size)
+					}
+				}
+			}
+
+			if tree.root != nil || tree.Height() != 0 {
+				t.Errorf("Tree after deleting every key has height %d, want an empty tree", tree.Height())
+			}
+		})
+	}
+}
+
+func TestAVLDelete(t *testing.T) {
+	/*
+		Keys 1..7 inserted in order build a perfect tree:
+		         4
+		       /   \
+		      2     6
+		     / \   / \
+		    1   3 5   7
+	*/
+	tests := []struct {
+		name    string
+		deletes []int
+		want    []int // Keys left, in order
+	}{
+		{"Leaf", []int{1}, []int{2, 3, 4, 5, 6, 7}},
+		{"One child", []int{1, 2}, []int{3, 4, 5, 6, 7}},
+		{"Two children", []int{6}, []int{1, 2, 3, 4, 5, 7}},
+		{"Root", []int{4}, []int{1, 2, 3, 5, 6, 7}},
+		{"Missing key", []int{8}, []int{1, 2, 3, 4, 5, 6, 7}},
+		{"Same key twice", []int{3, 3}, []int{1, 2, 4, 5, 6, 7}},
+		{"Rotation after a delete", []int{5, 7, 6}, []int{1, 2, 3, 4}},
+		{"Every key", []int{4, 2, 6, 1, 3, 5, 7}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree := NewAVL[int, int]()
+			for key := 1; key <= 7; key++ {
+				tree.Insert(key, key*key)
+			}
+
+			for _, key := range tt.deletes {
+				_, present := tree.Get(key)
+				if got := tree.Delete(key); got != present {
+					t.Errorf("Delete(%d) = %v, want %v", key, got, present)
+				}
+				if tree.Search(key) {
+					t.Errorf("Search(%d) after Delete = true, want false", key)
+				}
+			}
+
+			checkAVL(t, tree)
+			if got := keysOf(tree.All()); !slices.Equal(got, tt.want) {
+				t.Errorf("Keys after deleting %v = %v, want %v", tt.deletes, got, tt.want)
+			}
+			if tree.Len() != len(tt.want) {
+				t.Errorf("Len() = %d, want %d", tree.Len(), len(tt.want))
+			}
+			// Every key keeps its own value, including the ones moved up from a successor
+			for key, value := range tree.All() {
+				if value != key*key {
+					t.Errorf("Get(%d) = %d, want %d", key, value, key*key)
+				}
+			}
+		})
+	}
+}
+
+func TestAVLInsertSearchGet(t *testing.T) {
+	tree := NewAVL[int, string]()
+
+	// Operations on an empty tree
+	if tree.Search(10) {
+		t.Errorf("Search(10) on empty tree = true, want false")
+	}
+	if _, ok := tree.Min(); ok {
+		t.Errorf("Min() on empty tree reported a key")
+	}
+	if _, ok := tree.Max(); ok {
+		t.Errorf("Max() on empty tree reported a key")
+	}
+
+	for _, key := range []int{50, 30, 70, 20, 40, 60, 80} {
+		if inserted := tree.Insert(key, "v"); !inserted {
+			t.Errorf("Insert(%d) = false, want true", key)
+		}
+	}
+	if inserted := tree.Insert(40, "updated"); inserted {
+		t.Errorf("Insert of an existing key = true, want false")
+	}
+
+	tests := []struct {
+		key       int
+		wantFound bool
+		wantValue string
+	}{
+		{50, true, "v"},
+		{40, true, "updated"},
+		{80, true, "v"},
+		{45, false, ""},
+		{0, false, ""},
+	}
+	for _, tt := range tests {
+		if got := tree.Search(tt.key); got != tt.wantFound {
+			t.Errorf("Search(%d) = %v, want %v", tt.key, got, tt.wantFound)
+		}
+		if value, ok := tree.Get(tt.key); ok != tt.wantFound || value != tt.wantValue {
+			t.Errorf("Get(%d) = (%q, %v), want (%q, %v)", tt.key, value, ok, tt.wantValue, tt.wantFound)
+		}
+	}
+
+	if min, ok := tree.Min(); !ok || min != 20 {
+		t.Errorf("Min() = (%d, %v), want (20, true)", min, ok)
+	}
+	if max, ok := tree.Max(); !ok || max != 80 {
+		t.Errorf("Max() = (%d, %v), want (80, true)", max, ok)
+	}
+	if tree.Len() != 7 {
+		t.Errorf("Len() = %d, want 7", tree.Len())
+	}
+	checkAVL(t, tree)
+}
+
+func TestAVLRotations(t *testing.T) {
+	// Each insert order needs a different rotation to end up with 20 at the root
+	tests := []struct {
+		name string
+		keys []int
+	}{
+		{"Left-left", []int{30, 20, 10}},
+		{"Right-right", []int{10, 20, 30}},
+		{"Left-right", []int{30, 10, 20}},
+		{"Right-left", []int{10, 30, 20}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree := NewAVL[int, struct{}]()
+			for _, key := range tt.keys {
+				tree.Insert(key, struct{}{})
+			}
+
+			if got := tree.PreOrderTraversal(); !slices.Equal(got, []int{20, 10, 30}) {
+				t.Errorf("PreOrderTraversal() = %v, want [20 10 30]", got)
+			}
+			if tree.Height() != 2 {
+				t.Errorf("Height() = %d, want 2", tree.Height())
+			}
+			checkAVL(t, tree)
+		})
+	}
+}
+
+func TestAVLTraversals(t *testing.T) {
+	/*
+	   Inserting 1..7 in order produces a perfect tree:
+	         4
+	       /   \
+	      2     6
+	     / \   / \
+	    1   3 5   7
+	*/
+	tree := NewAVL[int, int]()
+	for key := 1; key <= 7; key++ {
+		tree.Insert(key, key*key)
+	}
+
+	tests := []struct {
+		name string
+		got  []int
+		want []int
+	}{
+		{"InOrderTraversal", tree.InOrderTraversal(), []int{1, 2, 3, 4, 5, 6, 7}},
+		{"PreOrderTraversal", tree.PreOrderTraversal(), []int{4, 2, 1, 3, 6, 5, 7}},
+		{"PostOrderTraversal", tree.PostOrderTraversal(), []int{1, 3, 2, 5, 7, 6, 4}},
+		{"PreOrder", slices.Collect(tree.PreOrder()), []int{4, 2, 1, 3, 6, 5, 7}},
+		{"PostOrder", slices.Collect(tree.PostOrder()), []int{1, 3, 2, 5, 7, 6, 4}},
+		{"LevelOrderTraversal", tree.LevelOrderTraversal(), []int{4, 2, 6, 1, 3, 5, 7}},
+		{"LevelOrder", slices.Collect(tree.LevelOrder()), []int{4, 2, 6, 1, 3, 5, 7}},
+		{"AllFrom(3)", keysOf(tree.AllFrom(3)), []int{3, 4, 5, 6, 7}},
+		{"AllFrom(0)", keysOf(tree.AllFrom(0)), []int{1, 2, 3, 4, 5, 6, 7}},
+		{"AllFrom(8)", keysOf(tree.AllFrom(8)), nil},
+	}
+	for _, tt := range tests {
+		if !slices.Equal(tt.got, tt.want) {
+			t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+
+	// All yields keys with their values and stops when asked
+	var keys []int
+	for k, v := range tree.All() {
+		if v != k*k {
+			t.Errorf("All() yielded (%d, %d), want value %d", k, v, k*k)
+		}
+		keys = append(keys, k)
+		if k == 5 {
+			break
+		}
+	}
+	if !slices.Equal(keys, []int{1, 2, 3, 4, 5}) {
+		t.Errorf("All() with break after 5 = %v, want [1 2 3 4 5]", keys)
+	}
+}
+
+func TestAVLOrderQueries(t *testing.T) {
+	// Even keys 0, 2, ..., 998 inserted in random order, so every odd key falls between two
+	r := rand.New(rand.NewPCG(5, 6))
+	tree := NewAVL[int, struct{}]()
+	keys := make([]int, 500)
+	for i := range keys {
+		keys[i] = 2 * i
+	}
+	for _, i := range r.Perm(len(keys)) {
+		tree.Insert(keys[i], struct{}{})
+	}
+	checkAVL(t, tree)
+
+	// Every query must agree with a binary search over the sorted keys
+	for key := -1; key <= 1000; key++ {
+		i, found := slices.BinarySearch(keys, key)
+		if got := tree.Rank(key); got != i {
+			t.Errorf("Rank(%d) = %d, want %d", key, got, i)
+		}
+
+		floor, hasFloor := i-1, i > 0
+		if found {
+			floor, hasFloor = i, true
+		}
+		if got, ok := tree.Floor(key); ok != hasFloor || (ok && got != keys[floor]) {
+			t.Errorf("Floor(%d) = (%d, %v)", key, got, ok)
+		}
+		if got, ok := tree.Ceiling(key); ok != (i < len(keys)) || (ok && got != keys[i]) {
+			t.Errorf("Ceiling(%d) = (%d, %v)", key, got, ok)
+		}
+		if got, ok := tree.Predecessor(key); ok != (i > 0) || (ok && got != keys[i-1]) {
+			t.Errorf("Predecessor(%d) = (%d, %v)", key, got, ok)
+		}
+		next := i
+		if found {
+			next++
+		}
+		if got, ok := tree.Successor(key); ok != (next < len(keys)) || (ok && got != keys[next]) {
+			t.Errorf("Successor(%d) = (%d, %v)", key, got, ok)
+		}
+	}
+
+	for i, key := range keys {
+		if got, ok := tree.Select(i); !ok || got != key {
+			t.Errorf("Select(%d) = (%d, %v), want (%d, true)", i, got, ok, key)
+		}
+	}
+	if _, ok := tree.Select(len(keys)); ok {
+		t.Errorf("Select(%d) reported a key for an out-of-range index", len(keys))
+	}
+
+	for _, bounds := range [][2]int{{-5, 5}, {100, 200}, {101, 199}, {998, 2000}, {51, 51}, {60, 40}} {
+		lo, hi := bounds[0], bounds[1]
+		want := []int{}
+		for _, key := range keys {
+			if lo <= key && key <= hi {
+				want = append(want, key)
+			}
+		}
+		if got := tree.Range(lo, hi); !slices.Equal(got, want) {
+			t.Errorf("Range(%d, %d) = %v, want %v", lo, hi, got, want)
+		}
+		if got := tree.CountRange(lo, hi); got != len(want) {
+			t.Errorf("CountRange(%d, %d) = %d, want %d", lo, hi, got, len(want))
+		}
+	}
+}
+
+func TestAVLComparator(t *testing.T) {
+	tree := NewAVLFunc[event, int](compareEvents)
+	for i := range 100 {
+		tree.Insert(event{fmt.Sprintf("tenant-%d", i%3), i}, i)
+	}
+	checkAVL(t, tree)
+
+	if got := tree.CountRange(event{"tenant-1", 0}, event{"tenant-1", 99}); got != 33 {
+		t.Errorf("CountRange over tenant-1 = %d, want 33", got)
+	}
+	if min, _ := tree.Min(); min != (event{"tenant-0", 0}) {
+		t.Errorf("Min() = %v, want {tenant-0 0}", min)
+	}
+
+	// A comparator option overrides the natural order
+	desc := NewAVL[int, int](WithComparator(func(a, b int) int { return cmp.Compare(b, a) }))
+	for key := range 10 {
+		desc.Insert(key, key)
+	}
+	if got := desc.InOrderTraversal(); !slices.Equal(got, []int{9, 8, 7, 6, 5, 4, 3, 2, 1, 0}) {
+		t.Errorf("InOrderTraversal() with a descending comparator = %v", got)
+	}
+}
@@ -1,5 +1,11 @@
 package bst
 
+import (
+	"iter"
+	"strconv"
+	"strings"
+)
+
 // Node represents a node in the binary search tree
 type Node struct {
 	Key   int
@@ -40,77 +46,105 @@ func (n *Node) Search(k int) bool {
 }
 
 // InOrderTraversal performs an in-order traversal of the tree and returns the keys
+// The keys are appended to a single slice while walking with an explicit stack, so there is no
+// copying between levels and no recursion however deep the tree is
+// Time complexity: O(n)
 func (n *Node) InOrderTraversal() []int {
-	if n == nil {
-		return []int{}
-	}
-
 	// In-order traversal: left -> root -> right
-	result := []int{}
-
-	// Traverse left subtree
-	if n.Left != nil {
-		result = append(result, n.Left.InOrderTraversal()...)
-	}
-
-	// Visit root
-	result = append(result, n.Key)
-
-	// Traverse right subtree
-	if n.Right != nil {
-		result = append(result, n.Right.InOrderTraversal()...)
-	}
-
-	return result
+	return collect(n, inOrder)
 }
 
 // PreOrderTraversal performs a pre-order traversal of the tree and returns the keys
+// Time complexity: O(n)
 func (n *Node) PreOrderTraversal() []int {
-	if n == nil {
-		return []int{}
-	}
-
 	// Pre-order traversal: root -> left -> right
-	result := []int{n.Key} // Visit root first
-
-	// Traverse left subtree
-	if n.Left != nil {
-		result = append(result, n.Left.PreOrderTraversal()...)
-	}
-
-	// Traverse right subtree
-	if n.Right != nil {
-		result = append(result, n.Right.PreOrderTraversal()...)
-	}
-
-	return result
+	return collect(n, preOrder)
 }
 
 // PostOrderTraversal performs a post-order traversal of the tree and returns the keys
+// Time complexity: O(n)
 func (n *Node) PostOrderTraversal() []int {
-	if n == nil {
-		return []int{}
+	// Post-order traversal: left -> right -> root
+	return collect(n, postOrder)
+}
+
+// LevelOrderTraversal performs a breadth-first traversal of the tree and returns the keys
+// level by level, from the root down and from left to right within each level
+// Time complexity: O(n)
+func (n *Node) LevelOrderTraversal() []int {
+	return collect(n, levelOrder)
+}
+
+// All returns an iterator over the keys of the tree in sorted (in-order) order
+// Time complexity: O(n) for a full iteration
+func (n *Node) All() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		inOrder(n, visitKeys(yield))
 	}
+}
 
-	// Post-order traversal: left -> right -> root
-	result := []int{}
+// AllFrom returns an iterator over the keys greater than or equal to the given key, in sorted order
+// The key need not be in the tree. The iterator is lazy: it only walks as far as the caller reads
+// Time complexity: O(h) to find the first key, where h is the height of the tree, then O(1)
+// amortized per key
+func (n *Node) AllFrom(key int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		// Stack the nodes whose keys are >= key on the path to it; each one is visited after
+		// everything in its left subtree that is also >= key
+		var stack []*Node
+		for m := n; m != nil; {
+			if key <= m.Key {
+				stack = append(stack, m)
+				m = m.Left
+			} else {
+				m = m.Right
+			}
+		}
+		ascend(stack, visitKeys(yield))
+	}
+}
 
-	// Traverse left subtree
-	if n.Left != nil {
-		result = append(result, n.Left.PostOrderTraversal()...)
+// PreOrder returns an iterator over the keys of the tree in pre-order (root -> left -> right)
+// Time complexity: O(n) for a full iteration
+func (n *Node) PreOrder() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		preOrder(n, visitKeys(yield))
 	}
+}
 
-	// Traverse right subtree
-	if n.Right != nil {
-		result = append(result, n.Right.PostOrderTraversal()...)
+// PostOrder returns an iterator over the keys of the tree in post-order (left -> right -> root)
+// Time complexity: O(n) for a full iteration
+func (n *Node) PostOrder() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		postOrder(n, visitKeys(yield))
 	}
+}
 
-	// Visit root
-	result = append(result, n.Key)
+// LevelOrder returns an iterator over the keys of the tree in level order (breadth-first)
+// Time complexity: O(n) for a full iteration
+func (n *Node) LevelOrder() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		levelOrder(n, visitKeys(yield))
+	}
+}
 
+// collect gathers the keys of the nodes visited by walking the tree from n into one slice
+func collect(n *Node, walk func(*Node, func(*Node) bool) bool) []int {
+	result := []int{}
+	walk(n, func(n *Node) bool {
+		result = append(result, n.Key)
+		return true
+	})
 	return result
 }
 
+// visitKeys adapts a key iterator's yield function to the node walks below
+func visitKeys(yield func(int) bool) func(*Node) bool {
+	return func(n *Node) bool {
+		return yield(n.Key)
+	}
+}
+
 // Min returns the minimum value in the BST
 func (n *Node) Min() int {
 	if n.Left == nil {
@@ -127,6 +161,206 @@ func (n *Node) Max() int {
 	return n.Right.Max()
 }
 
+// Height returns the number of nodes on the longest path from the node down to a leaf,
+// 1 for a single node and 0 for a nil tree
+// Time complexity: O(n)
+func (n *Node) Height() int {
+	height := 0
+	n.walkHeights(func(_ *Node, left, right int) bool {
+		height = 1 + max(left, right) // The root is visited last
+		return true
+	})
+	return height
+}
+
+// Size returns the number of keys in the tree, 0 for a nil tree
+// Time complexity: O(n)
+func (n *Node) Size() int {
+	size := 0
+	preOrder(n, func(*Node) bool {
+		size++
+		return true
+	})
+	return size
+}
+
+// IsValid checks the BST invariant: every key in a left subtree is smaller than its ancestor's
+// key and every key in a right subtree is larger. Nodes linked by hand through Left and Right
+// can break it, after which Search and Insert give wrong answers
+// It holds exactly when the in-order walk produces strictly increasing keys
+// Time complexity: O(n)
+func (n *Node) IsValid() bool {
+	var prev *Node
+	return inOrder(n, func(m *Node) bool {
+		if prev != nil && prev.Key >= m.Key {
+			return false
+		}
+		prev = m
+		return true
+	})
+}
+
+// IsBalanced checks that the heights of the two subtrees of every node differ by at most one,
+// the invariant an AVLTree maintains
+// Time complexity: O(n)
+func (n *Node) IsBalanced() bool {
+	return n.walkHeights(func(_ *Node, left, right int) bool {
+		return left-right <= 1 && right-left <= 1
+	})
+}
+
+// Equal reports whether the two trees have the same shape and the same key in every position
+// Trees holding the same keys in a different shape are not equal
+// Time complexity: O(n)
+func (n *Node) Equal(other *Node) bool {
+	stack := [][2]*Node{{n, other}}
+	for len(stack) > 0 {
+		pair := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		a, b := pair[0], pair[1]
+		switch {
+		case a == nil && b == nil:
+			continue
+		case a == nil || b == nil || a.Key != b.Key:
+			return false
+		}
+		stack = append(stack, [2]*Node{a.Left, b.Left}, [2]*Node{a.Right, b.Right})
+	}
+	return true
+}
+
+// Clone returns a deep copy of the tree, which can be modified without affecting the original
+// Time complexity: O(n)
+func (n *Node) Clone() *Node {
+	if n == nil {
+		return nil
+	}
+	root := &Node{Key: n.Key}
+	// Each entry pairs an original node with its copy, whose children are still to be made
+	stack := [][2]*Node{{n, root}}
+	for len(stack) > 0 {
+		pair := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		orig, clone := pair[0], pair[1]
+		if orig.Left != nil {
+			clone.Left = &Node{Key: orig.Left.Key}
+			stack = append(stack, [2]*Node{orig.Left, clone.Left})
+		}
+		if orig.Right != nil {
+			clone.Right = &Node{Key: orig.Right.Key}
+			stack = append(stack, [2]*Node{orig.Right, clone.Right})
+		}
+	}
+	return root
+}
+
+// String renders the shape of the tree as ASCII art, one line per level of nodes and one line
+// of branches between levels, for example:
+//
+//	  __100_
+//	 /      \
+//	50_    150
+//	   \
+//	  75
+//
+// A nil tree renders as "<nil>"
+// Time complexity: O(n * w) where w is the width of the rendering
+func (n *Node) String() string {
+	if n == nil {
+		return "<nil>"
+	}
+	lines, _, _ := n.render()
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// render lays out the subtree as lines of equal width
+// Returns:
+//   - []string: The lines, all of the same width
+//   - int: The width of the lines
+//   - int: The column of the middle of the root key, where the parent's branch attaches
+func (n *Node) render() ([]string, int, int) {
+	key := strconv.Itoa(n.Key)
+	u := len(key)
+
+	switch {
+	case n.Left == nil && n.Right == nil:
+		return []string{key}, u, u / 2
+
+	case n.Right == nil: // The key sits to the right of its left subtree
+		lines, w, x := n.Left.render()
+		first := pad(x+1) + strings.Repeat("_", w-x-1) + key
+		second := pad(x) + "/" + pad(w-x-1+u)
+		return append([]string{first, second}, shift(lines, 0, u)...), w + u, w + u/2
+
+	case n.Left == nil: // The key sits to the left of its right subtree
+		lines, w, x := n.Right.render()
+		first := key + strings.Repeat("_", x) + pad(w-x)
+		second := pad(u+x) + "\\" + pad(w-x-1)
+		return append([]string{first, second}, shift(lines, u, 0)...), w + u, u / 2
+	}
+
+	// Two children: the key sits between the subtrees
+	left, lw, lx := n.Left.render()
+	right, rw, rx := n.Right.render()
+	first := pad(lx+1) + strings.Repeat("_", lw-lx-1) + key + strings.Repeat("_", rx) + pad(rw-rx)
+	second := pad(lx) + "/" + pad(lw-lx-1+u+rx) + "\\" + pad(rw-rx-1)
+	lines := []string{first, second}
+	for i := range max(len(left), len(right)) {
+		l, r := pad(lw), pad(rw) // The shorter subtree is padded with blank lines
+		if i < len(left) {
+			l = left[i]
+		}
+		if i < len(right) {
+			r = right[i]
+		}
+		lines = append(lines, l+pad(u)+r)
+	}
+	return lines, lw + u + rw, lw + u/2
+}
+
+// pad returns n spaces
+func pad(n int) string {
+	return strings.Repeat(" ", n)
+}
+
+// shift pads every line with before spaces on the left and after spaces on the right
+func shift(lines []string, before, after int) []string {
+	for i, line := range lines {
+		lines[i] = pad(before) + line + pad(after)
+	}
+	return lines
+}
+
+// walkHeights visits the subtree in post-order, passing each node together with the heights of
+// its left and right subtrees, and reports false once visit asks to stop
+func (n *Node) walkHeights(visit func(n *Node, left, right int) bool) bool {
+	// In post-order both subtrees are finished right before their parent, so their heights are
+	// the top entries of the stack: the right one above the left one
+	var heights []int
+	pop := func() int {
+		h := heights[len(heights)-1]
+		heights = heights[:len(heights)-1]
+		return h
+	}
+	return postOrder(n, func(m *Node) bool {
+		left, right := 0, 0
+		if m.Right != nil {
+			right = pop()
+		}
+		if m.Left != nil {
+			left = pop()
+		}
+		if !visit(m, left, right) {
+			return false
+		}
+		heights = append(heights, 1+max(left, right))
+		return true
+	})
+}
+
 // NewBST creates a new binary search tree with the given root key
 func NewBST(key int) *Node {
 	return &Node{Key: key}
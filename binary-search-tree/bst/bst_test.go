@@ -1,7 +1,9 @@
 package bst
 
 import (
+	"iter"
 	"reflect"
+	"slices"
 	"testing"
 )
 
@@ -102,6 +104,282 @@ func TestPostOrderTraversal(t *testing.T) {
 	}
 }
 
+func TestLevelOrderTraversal(t *testing.T) {
+	tree := setupTestTree()
+	expected := []int{100, 50, 150, 25, 75, 125, 175}
+	result := tree.LevelOrderTraversal()
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("LevelOrderTraversal() = %v, expected %v", result, expected)
+	}
+}
+
+func TestAllFrom(t *testing.T) {
+	tree := setupTestTree()
+
+	testCases := []struct {
+		key      int
+		expected []int
+	}{
+		{0, []int{25, 50, 75, 100, 125, 150, 175}},  // below the minimum
+		{25, []int{25, 50, 75, 100, 125, 150, 175}}, // the minimum itself
+		{100, []int{100, 125, 150, 175}},            // the root
+		{101, []int{125, 150, 175}},                 // between keys
+		{76, []int{100, 125, 150, 175}},             // successor is an ancestor
+		{175, []int{175}},                           // the maximum
+		{200, nil},                                  // above the maximum
+	}
+
+	for _, tc := range testCases {
+		if result := slices.Collect(tree.AllFrom(tc.key)); !slices.Equal(result, tc.expected) {
+			t.Errorf("AllFrom(%d) = %v, expected %v", tc.key, result, tc.expected)
+		}
+	}
+}
+
+func TestTraversalsOfEmptyTree(t *testing.T) {
+	var tree *Node
+
+	traversals := map[string][]int{
+		"InOrderTraversal":    tree.InOrderTraversal(),
+		"PreOrderTraversal":   tree.PreOrderTraversal(),
+		"PostOrderTraversal":  tree.PostOrderTraversal(),
+		"LevelOrderTraversal": tree.LevelOrderTraversal(),
+	}
+	for name, result := range traversals {
+		if result == nil || len(result) != 0 {
+			t.Errorf("%s() on a nil tree = %#v, expected an empty slice", name, result)
+		}
+	}
+	if keys := slices.Collect(tree.AllFrom(0)); len(keys) != 0 {
+		t.Errorf("AllFrom(0) on a nil tree = %v, expected no keys", keys)
+	}
+}
+
+// degenerateTree links n nodes into a right-leaning chain with keys 0..n-1, the shape sorted
+// inserts produce, without the quadratic cost of inserting them one by one
+func degenerateTree(n int) *Node {
+	root := NewBST(0)
+	for tail, key := root, 1; key < n; key++ {
+		tail.Right = &Node{Key: key}
+		tail = tail.Right
+	}
+	return root
+}
+
+func TestTraversalsOfDegenerateTree(t *testing.T) {
+	const n = 1_000_000
+	tree := degenerateTree(n)
+
+	inOrder := tree.InOrderTraversal()
+	if len(inOrder) != n || !slices.IsSorted(inOrder) {
+		t.Fatalf("InOrderTraversal() returned %d keys, sorted: %v", len(inOrder), slices.IsSorted(inOrder))
+	}
+	// A right-leaning chain looks the same in pre-order and level order, and reversed in post-order
+	if !slices.Equal(tree.PreOrderTraversal(), inOrder) {
+		t.Errorf("PreOrderTraversal() of a right-leaning chain is not the sorted keys")
+	}
+	if !slices.Equal(tree.LevelOrderTraversal(), inOrder) {
+		t.Errorf("LevelOrderTraversal() of a right-leaning chain is not the sorted keys")
+	}
+	postOrder := tree.PostOrderTraversal()
+	slices.Reverse(postOrder)
+	if !slices.Equal(postOrder, inOrder) {
+		t.Errorf("PostOrderTraversal() of a right-leaning chain is not the reversed keys")
+	}
+
+	// Starting deep in the chain only yields the keys from there on
+	if first, ok := firstKey(tree.AllFrom(n - 3)); !ok || first != n-3 {
+		t.Errorf("AllFrom(%d) starts at (%d, %v)", n-3, first, ok)
+	}
+}
+
+// firstKey returns the first key of the sequence, if any
+func firstKey(seq iter.Seq[int]) (int, bool) {
+	for key := range seq {
+		return key, true
+	}
+	return 0, false
+}
+
+// BenchmarkTraversal measures building the full key slice of a balanced tree
+func BenchmarkTraversal(b *testing.B) {
+	const n = 1 << 16
+	// Inserting the midpoints of ever smaller ranges first yields a perfectly balanced tree
+	tree := NewBST(n / 2)
+	for step := n / 2; step > 1; step /= 2 {
+		for key := step / 2; key < n; key += step {
+			tree.Insert(key)
+		}
+	}
+
+	traversals := []struct {
+		name string
+		fn   func() []int
+	}{
+		{"InOrder", tree.InOrderTraversal},
+		{"PreOrder", tree.PreOrderTraversal},
+		{"PostOrder", tree.PostOrderTraversal},
+		{"LevelOrder", tree.LevelOrderTraversal},
+	}
+	for _, tt := range traversals {
+		b.Run(tt.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				tt.fn()
+			}
+		})
+	}
+}
+
+// buildBST inserts the keys into a new Node in the given order, the first key becoming the root
+func buildBST(keys ...int) *Node {
+	tree := NewBST(keys[0])
+	for _, key := range keys[1:] {
+		tree.Insert(key)
+	}
+	return tree
+}
+
+func TestHeightSizeBalance(t *testing.T) {
+	testCases := []struct {
+		name         string
+		tree         *Node
+		wantHeight   int
+		wantSize     int
+		wantBalanced bool
+	}{
+		{"Nil tree", nil, 0, 0, true},
+		{"Single node", NewBST(1), 1, 1, true},
+		{"Perfect tree", setupTestTree(), 3, 7, true},
+		{"Missing one grandchild", buildBST(100, 50, 150, 25), 3, 4, true},
+		{"Root leaning left by two", buildBST(100, 50, 25), 3, 3, false},
+		{"Subtree leaning right by two", buildBST(100, 50, 150, 25, 175, 200, 300), 5, 7, false},
+		{"Degenerate chain", degenerateTree(1000), 1000, 1000, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.tree.Height(); got != tc.wantHeight {
+				t.Errorf("Height() = %d, expected %d", got, tc.wantHeight)
+			}
+			if got := tc.tree.Size(); got != tc.wantSize {
+				t.Errorf("Size() = %d, expected %d", got, tc.wantSize)
+			}
+			if got := tc.tree.IsBalanced(); got != tc.wantBalanced {
+				t.Errorf("IsBalanced() = %v, expected %v", got, tc.wantBalanced)
+			}
+		})
+	}
+}
+
+func TestIsValid(t *testing.T) {
+	testCases := []struct {
+		name string
+		tree *Node
+		want bool
+	}{
+		{"Nil tree", nil, true},
+		{"Built by Insert", setupTestTree(), true},
+		{"Left child larger than parent", &Node{Key: 10, Left: &Node{Key: 20}}, false},
+		{"Duplicate key", &Node{Key: 10, Right: &Node{Key: 10}}, false},
+		{
+			// Every parent-child pair is ordered, but 110 is in the left subtree of 100
+			"Grandchild on the wrong side of the root",
+			&Node{Key: 100, Left: &Node{Key: 50, Right: &Node{Key: 110}}, Right: &Node{Key: 150}},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.tree.IsValid(); got != tc.want {
+			t.Errorf("%s: IsValid() = %v, expected %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestEqualAndClone(t *testing.T) {
+	tree := setupTestTree()
+
+	testCases := []struct {
+		name  string
+		other *Node
+		want  bool
+	}{
+		{"Same inserts", setupTestTree(), true},
+		{"Itself", tree, true},
+		{"Same keys, different shape", buildBST(25, 50, 75, 100, 125, 150, 175), false},
+		{"Missing a leaf", buildBST(100, 50, 150, 25, 75, 125), false},
+		{"Different key", buildBST(100, 50, 150, 25, 75, 125, 176), false},
+		{"Nil", nil, false},
+	}
+	for _, tc := range testCases {
+		if got := tree.Equal(tc.other); got != tc.want {
+			t.Errorf("%s: Equal() = %v, expected %v", tc.name, got, tc.want)
+		}
+	}
+	var empty *Node
+	if !empty.Equal(nil) {
+		t.Errorf("Two nil trees are not Equal")
+	}
+
+	clone := tree.Clone()
+	if !clone.Equal(tree) {
+		t.Fatalf("Clone() =\n%v\nexpected\n%v", clone, tree)
+	}
+	// The clone shares no nodes with the original
+	clone.Insert(60)
+	clone.Left.Key = 49
+	if !tree.Equal(setupTestTree()) {
+		t.Errorf("Modifying the clone changed the original:\n%v", tree)
+	}
+	if empty.Clone() != nil {
+		t.Errorf("Clone() of a nil tree is not nil")
+	}
+}
+
+func TestString(t *testing.T) {
+	testCases := []struct {
+		name string
+		tree *Node
+		want string
+	}{
+		{"Nil tree", nil, "<nil>"},
+		{"Single node", NewBST(7), "7"},
+		{"Left child only", buildBST(20, 10), "  20\n /\n10"},
+		{"Right child only", buildBST(10, 20), "10_\n   \\\n  20"},
+		{
+			"Perfect tree",
+			setupTestTree(),
+			"" +
+				"    __100____\n" +
+				"   /         \\\n" +
+				"  50_      _150_\n" +
+				" /   \\    /     \\\n" +
+				"25  75   125   175",
+		},
+		{
+			"Subtrees of different heights",
+			buildBST(100, 50, 150, 75),
+			"" +
+				"  __100_\n" +
+				" /      \\\n" +
+				"50_    150\n" +
+				"   \\\n" +
+				"  75",
+		},
+		{"Negative keys", buildBST(0, -5, 5), "  0\n / \\\n-5 5"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.tree.String(); got != tc.want {
+				t.Errorf("String() =\n%s\nexpected\n%s", got, tc.want)
+			}
+		})
+	}
+}
+
 func TestMinMax(t *testing.T) {
 	tree := setupTestTree()
 
@@ -113,3 +391,39 @@ func TestMinMax(t *testing.T) {
 		t.Errorf("Max() = %d, expected 175", max)
 	}
 }
+
+func TestIterators(t *testing.T) {
+	tree := setupTestTree()
+
+	testCases := []struct {
+		name     string
+		seq      iter.Seq[int]
+		expected []int
+	}{
+		{"All", tree.All(), tree.InOrderTraversal()},
+		{"PreOrder", tree.PreOrder(), tree.PreOrderTraversal()},
+		{"PostOrder", tree.PostOrder(), tree.PostOrderTraversal()},
+		{"LevelOrder", tree.LevelOrder(), tree.LevelOrderTraversal()},
+		{"AllFrom", tree.AllFrom(60), []int{75, 100, 125, 150, 175}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if result := slices.Collect(tc.seq); !slices.Equal(result, tc.expected) {
+				t.Errorf("%s() = %v, expected %v", tc.name, result, tc.expected)
+			}
+
+			// Stopping early must not visit the rest of the tree
+			var first []int
+			for k := range tc.seq {
+				first = append(first, k)
+				if len(first) == 3 {
+					break
+				}
+			}
+			if !slices.Equal(first, tc.expected[:3]) {
+				t.Errorf("%s() first three = %v, expected %v", tc.name, first, tc.expected[:3])
+			}
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package bst
+
+import "fmt"
+
+// config holds the settings collected from Options before a Tree or AVLTree is built
+type config[K any] struct {
+	compare func(a, b K) int // Orders the keys: negative if a < b, zero if equal, positive if a > b
+}
+
+// Option configures a Tree created by NewTree or an AVLTree created by NewAVL
+type Option[K any] func(*config[K])
+
+// WithComparator orders the keys with compare instead of their natural order
+// compare must return a negative number if a sorts before b, zero if they are equal and a positive
+// number otherwise, like cmp.Compare, and it must be consistent for the lifetime of the tree
+func WithComparator[K any](compare func(a, b K) int) Option[K] {
+	return func(c *config[K]) {
+		c.compare = compare
+	}
+}
+
+// buildConfig applies the options on top of the default comparator
+// It panics if no comparator is left
+func buildConfig[K any](compare func(a, b K) int, opts []Option[K]) config[K] {
+	c := config[K]{compare: compare}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	if c.compare == nil {
+		panic(fmt.Sprintf("bst: nil comparator for keys of type %T", *new(K)))
+	}
+	return c
+}
+
+// node represents a node in a Tree or an AVLTree
+type node[K, V any] struct {
+	key    K
+	value  V
+	left   *node[K, V]
+	right  *node[K, V]
+	size   int // Number of keys in the subtree rooted at this node, used for rank and select
+	height int // Number of nodes on the longest path down to a leaf, maintained by AVLTree only
+}
+
+// subtreeSize returns the number of keys in the subtree, 0 for an empty one
+func (n *node[K, V]) subtreeSize() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// find returns the node holding the key, or nil if the key is absent
+func (n *node[K, V]) find(key K, compare func(a, b K) int) *node[K, V] {
+	for n != nil {
+		switch c := compare(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+// min returns the leftmost node of the subtree, which must not be empty
+func (n *node[K, V]) min() *node[K, V] {
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+// max returns the rightmost node of the subtree, which must not be empty
+func (n *node[K, V]) max() *node[K, V] {
+	for n.right != nil {
+		n = n.right
+	}
+	return n
+}
+
+// closest returns the nearest key below (below = true) or above the given key in the subtree,
+// including the key itself when inclusive is true
+func (n *node[K, V]) closest(key K, compare func(a, b K) int, below, inclusive bool) (K, bool) {
+	var best K
+	found := false
+	for n != nil {
+		switch c := compare(n.key, key); {
+		case c < 0:
+			// A candidate from below, and anything closer is to its right
+			if below {
+				best, found = n.key, true
+			}
+			n = n.right
+		case c > 0:
+			// A candidate from above, and anything closer is to its left
+			if !below {
+				best, found = n.key, true
+			}
+			n = n.left
+		case inclusive:
+			return n.key, true
+		case below:
+			n = n.left // The predecessor of a present key is the largest key of its left subtree
+		default:
+			n = n.right // The successor of a present key is the smallest key of its right subtree
+		}
+	}
+	return best, found
+}
+
+// rank returns the number of keys in the subtree strictly less than the given key
+func (n *node[K, V]) rank(key K, compare func(a, b K) int) int {
+	rank := 0
+	for n != nil {
+		switch c := compare(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			rank += n.left.subtreeSize() + 1 // The whole left subtree and the node itself are smaller
+			n = n.right
+		default:
+			return rank + n.left.subtreeSize()
+		}
+	}
+	return rank
+}
+
+// selectAt returns the node at index i of the subtree in sorted order, or nil if i is out of range
+func (n *node[K, V]) selectAt(i int) *node[K, V] {
+	if i < 0 || i >= n.subtreeSize() {
+		return nil
+	}
+	for n != nil {
+		left := n.left.subtreeSize()
+		switch {
+		case i < left:
+			n = n.left
+		case i > left:
+			i -= left + 1 // Skip the left subtree and the node itself
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return nil // Unreachable while the subtree sizes are consistent
+}
+
+// rangeKeys appends the keys of the subtree that lie within [lo, hi] to keys, in sorted order
+// It walks in order from lo and stops at the first key past hi, so it is iterative and only
+// visits the path to lo and the keys returned
+func (n *node[K, V]) rangeKeys(lo, hi K, compare func(a, b K) int, keys *[]K) {
+	n.inOrderFrom(lo, compare, func(m *node[K, V]) bool {
+		if compare(m.key, hi) > 0 {
+			return false
+		}
+		*keys = append(*keys, m.key)
+		return true
+	})
+}
+
+// countRange returns the number of keys k in the subtree with lo <= k <= hi
+func (n *node[K, V]) countRange(lo, hi K, compare func(a, b K) int) int {
+	if compare(lo, hi) > 0 {
+		return 0
+	}
+	count := n.rank(hi, compare) - n.rank(lo, compare)
+	if n.find(hi, compare) != nil {
+		count++ // rank counts keys strictly below hi
+	}
+	return count
+}
+
+// collectKeys gathers the keys visited by walking the subtree from root into a slice with room
+// for size keys
+func collectKeys[K, V any](size int, root *node[K, V], walk func(*node[K, V], func(*node[K, V]) bool) bool) []K {
+	keys := make([]K, 0, size)
+	walk(root, func(n *node[K, V]) bool {
+		keys = append(keys, n.key)
+		return true
+	})
+	return keys
+}
+
+// inOrderFrom visits the nodes of the subtree whose keys are >= key in order and reports false
+// once visit asks to stop
+func (n *node[K, V]) inOrderFrom(key K, compare func(a, b K) int, visit func(*node[K, V]) bool) bool {
+	// Stack the nodes whose keys are >= key on the path to it; each one is visited after
+	// everything in its left subtree that is also >= key
+	var stack []*node[K, V]
+	for n != nil {
+		if compare(key, n.key) <= 0 {
+			stack = append(stack, n)
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+	return ascend(stack, visit)
+}
@@ -0,0 +1,329 @@
+package bst
+
+import (
+	"cmp"
+	"iter"
+)
+
+// Tree is a binary search tree mapping keys of type K to values of type V that owns its root pointer
+// A bare *Node cannot represent an empty tree, and deleting the root key would need the
+// caller's pointer to change; Tree handles both, and keeps track of its size
+// Keys are ordered by cmp.Compare, or by the comparator given to NewTreeFunc or WithComparator
+type Tree[K, V any] struct {
+	root    *node[K, V]
+	size    int              // Number of keys in the tree
+	compare func(a, b K) int // Orders the keys
+}
+
+// NewTree creates a new, empty binary search tree whose keys are ordered by cmp.Compare
+// Parameters:
+//   - opts: Options such as WithComparator
+func NewTree[K cmp.Ordered, V any](opts ...Option[K]) *Tree[K, V] {
+	return NewTreeFunc[K, V](cmp.Compare[K], opts...)
+}
+
+// NewTreeFunc creates a new, empty binary search tree whose keys are ordered by compare, for key
+// types such as structs that have no natural order
+// It panics if compare is nil
+// Parameters:
+//   - compare: Returns a negative number if a < b, zero if a == b and a positive number if a > b
+//   - opts: Options such as WithComparator
+func NewTreeFunc[K, V any](compare func(a, b K) int, opts ...Option[K]) *Tree[K, V] {
+	c := buildConfig(compare, opts)
+	return &Tree[K, V]{compare: c.compare}
+}
+
+// Insert stores the value for the key, replacing the value if the key is already present
+// Time complexity: O(h) where h is the height of the tree
+// Returns:
+//   - bool: True if the key was new, false if an existing value was replaced
+func (t *Tree[K, V]) Insert(key K, value V) bool {
+	if n := *t.find(key); n != nil {
+		n.value = value
+		return false
+	}
+
+	// Walk down again, counting the new key in every subtree on the path
+	link := &t.root
+	for *link != nil {
+		n := *link
+		n.size++
+		if t.compare(key, n.key) < 0 {
+			link = &n.left
+		} else {
+			link = &n.right
+		}
+	}
+	*link = &node[K, V]{key: key, value: value, size: 1}
+	t.size++
+	return true
+}
+
+// Search checks if the key exists in the tree
+// Time complexity: O(h) where h is the height of the tree
+func (t *Tree[K, V]) Search(key K) bool {
+	return *t.find(key) != nil
+}
+
+// Get returns the value stored for the key
+// Time complexity: O(h) where h is the height of the tree
+// Returns:
+//   - V: The stored value, or the zero value if the key is absent
+//   - bool: True if the key was found
+func (t *Tree[K, V]) Get(key K) (V, bool) {
+	if n := *t.find(key); n != nil {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Delete removes the key and its value from the tree
+// A node with two children takes the key and value of its in-order successor (the smallest key
+// of its right subtree), and the successor node, which has no left child, is unlinked instead
+// Time complexity: O(h) where h is the height of the tree
+// Returns:
+//   - bool: True if the key was found and deleted
+func (t *Tree[K, V]) Delete(key K) bool {
+	if *t.find(key) == nil {
+		return false
+	}
+
+	// Walk down again, removing the key from the count of every subtree on the path
+	link := &t.root
+	for {
+		n := *link
+		c := t.compare(key, n.key)
+		if c == 0 {
+			break
+		}
+		n.size--
+		if c < 0 {
+			link = &n.left
+		} else {
+			link = &n.right
+		}
+	}
+	n := *link
+
+	switch {
+	case n.left == nil: // Leaf or only a right child: the child takes the node's place
+		*link = n.right
+	case n.right == nil: // Only a left child
+		*link = n.left
+	default: // Two children: replace the entry with the in-order successor's
+		n.size--
+		succLink := &n.right
+		for (*succLink).left != nil {
+			(*succLink).size--
+			succLink = &(*succLink).left
+		}
+		succ := *succLink
+		n.key, n.value = succ.key, succ.value
+		*succLink = succ.right
+	}
+	t.size--
+	return true
+}
+
+// Min returns the smallest key in the tree
+// Time complexity: O(h) where h is the height of the tree
+// Returns:
+//   - K: The smallest key, or the zero value if the tree is empty
+//   - bool: True if the tree is not empty
+func (t *Tree[K, V]) Min() (K, bool) {
+	if t.root == nil {
+		var zero K
+		return zero, false
+	}
+	return t.root.min().key, true
+}
+
+// Max returns the largest key in the tree
+// Time complexity: O(h) where h is the height of the tree
+// Returns:
+//   - K: The largest key, or the zero value if the tree is empty
+//   - bool: True if the tree is not empty
+func (t *Tree[K, V]) Max() (K, bool) {
+	if t.root == nil {
+		var zero K
+		return zero, false
+	}
+	return t.root.max().key, true
+}
+
+// Len returns the number of keys in the tree
+// Time complexity: O(1)
+func (t *Tree[K, V]) Len() int {
+	return t.size
+}
+
+// InOrderTraversal returns the keys of the tree in sorted order
+// Time complexity: O(n)
+func (t *Tree[K, V]) InOrderTraversal() []K {
+	return collectKeys(t.size, t.root, inOrder)
+}
+
+// PreOrderTraversal returns the keys of the tree in pre-order (root -> left -> right)
+// Time complexity: O(n)
+func (t *Tree[K, V]) PreOrderTraversal() []K {
+	return collectKeys(t.size, t.root, preOrder)
+}
+
+// PostOrderTraversal returns the keys of the tree in post-order (left -> right -> root)
+// Time complexity: O(n)
+func (t *Tree[K, V]) PostOrderTraversal() []K {
+	return collectKeys(t.size, t.root, postOrder)
+}
+
+// LevelOrderTraversal returns the keys of the tree level by level, from the root down and from
+// left to right within each level
+// Time complexity: O(n)
+func (t *Tree[K, V]) LevelOrderTraversal() []K {
+	return collectKeys(t.size, t.root, levelOrder)
+}
+
+// All returns an iterator over the keys and values of the tree in sorted order
+// The tree must not be modified while iterating
+// Time complexity: O(n) for a full iteration
+func (t *Tree[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		inOrder(t.root, func(n *node[K, V]) bool {
+			return yield(n.key, n.value)
+		})
+	}
+}
+
+// AllFrom returns an iterator over the keys >= the given key and their values, in sorted order
+// The key need not be in the tree. The iterator is lazy: it only walks as far as the caller reads
+// The tree must not be modified while iterating
+// Time complexity: O(h) to find the first key, where h is the height of the tree, then O(1) amortized per key
+func (t *Tree[K, V]) AllFrom(key K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.root.inOrderFrom(key, t.compare, func(n *node[K, V]) bool {
+			return yield(n.key, n.value)
+		})
+	}
+}
+
+// PreOrder returns an iterator over the keys of the tree in pre-order (root -> left -> right)
+// Time complexity: O(n) for a full iteration
+func (t *Tree[K, V]) PreOrder() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		preOrder(t.root, func(n *node[K, V]) bool {
+			return yield(n.key)
+		})
+	}
+}
+
+// PostOrder returns an iterator over the keys of the tree in post-order (left -> right -> root)
+// Time complexity: O(n) for a full iteration
+func (t *Tree[K, V]) PostOrder() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		postOrder(t.root, func(n *node[K, V]) bool {
+			return yield(n.key)
+		})
+	}
+}
+
+// LevelOrder returns an iterator over the keys of the tree level by level, from the root down and
+// from left to right within each level
+// Time complexity: O(n) for a full iteration
+func (t *Tree[K, V]) LevelOrder() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		levelOrder(t.root, func(n *node[K, V]) bool {
+			return yield(n.key)
+		})
+	}
+}
+
+// Floor returns the largest key less than or equal to the given key
+// Time complexity: O(h) where h is the height of the tree
+// Returns:
+//   - K: The floor, or the zero value if there is none
+//   - bool: True if a floor exists
+func (t *Tree[K, V]) Floor(key K) (K, bool) {
+	return t.root.closest(key, t.compare, true, true)
+}
+
+// Ceiling returns the smallest key greater than or equal to the given key
+// Time complexity: O(h) where h is the height of the tree
+// Returns:
+//   - K: The ceiling, or the zero value if there is none
+//   - bool: True if a ceiling exists
+func (t *Tree[K, V]) Ceiling(key K) (K, bool) {
+	return t.root.closest(key, t.compare, false, true)
+}
+
+// Predecessor returns the largest key strictly less than the given key, which need not be in the tree
+// Time complexity: O(h) where h is the height of the tree
+// Returns:
+//   - K: The predecessor, or the zero value if there is none
+//   - bool: True if a predecessor exists
+func (t *Tree[K, V]) Predecessor(key K) (K, bool) {
+	return t.root.closest(key, t.compare, true, false)
+}
+
+// Successor returns the smallest key strictly greater than the given key, which need not be in the tree
+// Time complexity: O(h) where h is the height of the tree
+// Returns:
+//   - K: The successor, or the zero value if there is none
+//   - bool: True if a successor exists
+func (t *Tree[K, V]) Successor(key K) (K, bool) {
+	return t.root.closest(key, t.compare, false, false)
+}
+
+// Rank returns the number of keys strictly less than the given key, which need not be in the tree
+// If the key is present, this is its index in sorted order
+// Time complexity: O(h) where h is the height of the tree, thanks to the subtree sizes stored in
+// every node; no subtree is walked
+func (t *Tree[K, V]) Rank(key K) int {
+	return t.root.rank(key, t.compare)
+}
+
+// Select returns the key at index i in sorted order, so Select(0) is the minimum
+// It is the inverse of Rank: Select(Rank(k)) == k for every key k in the tree
+// Time complexity: O(h) where h is the height of the tree
+// Returns:
+//   - K: The key at index i, or the zero value if i is out of range
+//   - bool: True if 0 <= i < Len()
+func (t *Tree[K, V]) Select(i int) (K, bool) {
+	if n := t.root.selectAt(i); n != nil {
+		return n.key, true
+	}
+	var zero K
+	return zero, false
+}
+
+// Range returns the keys k with lo <= k <= hi, in sorted order
+// Subtrees that lie entirely outside the range are never visited
+// Time complexity: O(h + m) where h is the height of the tree and m the number of keys returned
+func (t *Tree[K, V]) Range(lo, hi K) []K {
+	keys := []K{}
+	t.root.rangeKeys(lo, hi, t.compare, &keys)
+	return keys
+}
+
+// CountRange returns the number of keys k with lo <= k <= hi
+// Time complexity: O(h) where h is the height of the tree, using two rank queries
+func (t *Tree[K, V]) CountRange(lo, hi K) int {
+	return t.root.countRange(lo, hi, t.compare)
+}
+
+// find returns the link (the root pointer or a child pointer) that points to the key's node,
+// or the nil link where the key would be inserted
+func (t *Tree[K, V]) find(key K) **node[K, V] {
+	link := &t.root
+	for *link != nil {
+		n := *link
+		switch c := t.compare(key, n.key); {
+		case c < 0:
+			link = &n.left
+		case c > 0:
+			link = &n.right
+		default:
+			return link
+		}
+	}
+	return link
+}
@@ -0,0 +1,558 @@
+package bst
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// buildTree inserts the keys into a new Tree in the given order, each with its own key as value
+func buildTree(keys ...int) *Tree[int, int] {
+	tree := NewTree[int, int]()
+	for _, key := range keys {
+		tree.Insert(key, key)
+	}
+	return tree
+}
+
+// checkTree verifies the BST order, the value and the subtree size of every node, and that the
+// tree holds exactly the wanted keys
+func checkTree(t *testing.T, tree *Tree[int, int], want []int) {
+	t.Helper()
+
+	var check func(n *node[int, int], lo, hi int) int
+	check = func(n *node[int, int], lo, hi int) int {
+		if n == nil {
+			return 0
+		}
+		if n.key <= lo || n.key >= hi {
+			t.Errorf("Key %d is outside its allowed range (%d, %d)", n.key, lo, hi)
+		}
+		if n.value != n.key {
+			t.Errorf("Key %d carries the value %d of another key", n.key, n.value)
+		}
+		size := 1 + check(n.left, lo, n.key) + check(n.right, n.key, hi)
+		if n.size != size {
+			t.Errorf("Node %d stores size %d, want %d", n.key, n.size, size)
+		}
+		return size
+	}
+	check(tree.root, math.MinInt, math.MaxInt)
+
+	if got := tree.InOrderTraversal(); !slices.Equal(got, want) {
+		t.Errorf("InOrderTraversal() = %v, want %v", got, want)
+	}
+	if tree.Len() != len(want) {
+		t.Errorf("Len() = %d, want %d", tree.Len(), len(want))
+	}
+}
+
+func TestTreeInsert(t *testing.T) {
+	tree := NewTree[int, int]()
+	if tree.root != nil || tree.Len() != 0 {
+		t.Errorf("New tree has root %v and Len() = %d, want an empty tree", tree.root, tree.Len())
+	}
+
+	for _, key := range []int{100, 50, 150} {
+		if !tree.Insert(key, key) {
+			t.Errorf("Insert(%d) = false, want true", key)
+		}
+	}
+	if tree.Insert(50, 50) {
+		t.Errorf("Insert of an existing key = true, want false")
+	}
+
+	if root := tree.root; root.key != 100 || root.left.key != 50 || root.right.key != 150 {
+		t.Errorf("Tree shape is wrong: %v", tree.PreOrderTraversal())
+	}
+	checkTree(t, tree, []int{50, 100, 150})
+}
+
+func TestTreeDelete(t *testing.T) {
+	/*
+	   Every test starts from this tree:
+	         100
+	        /   \
+	      50     150
+	     /  \      \
+	   25    75     175
+	         /
+	        60
+	*/
+	initial := []int{100, 50, 150, 25, 75, 175, 60}
+
+	tests := []struct {
+		name         string
+		key          int
+		wantDeleted  bool
+		wantPreOrder []int
+	}{
+		{"Leaf", 25, true, []int{100, 50, 75, 60, 150, 175}},
+		{"Leaf under one-child node", 60, true, []int{100, 50, 25, 75, 150, 175}},
+		{"Only left child", 75, true, []int{100, 50, 25, 60, 150, 175}},
+		{"Only right child", 150, true, []int{100, 50, 25, 75, 60, 175}},
+		{"Two children, successor deeper in right subtree", 50, true, []int{100, 60, 25, 75, 150, 175}},
+		{"Root with two children", 100, true, []int{150, 50, 25, 75, 60, 175}},
+		{"Missing key", 80, false, []int{100, 50, 25, 75, 60, 150, 175}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree := buildTree(initial...)
+
+			if got := tree.Delete(tt.key); got != tt.wantDeleted {
+				t.Errorf("Delete(%d) = %v, want %v", tt.key, got, tt.wantDeleted)
+			}
+			if got := tree.PreOrderTraversal(); !slices.Equal(got, tt.wantPreOrder) {
+				t.Errorf("PreOrderTraversal() after Delete(%d) = %v, want %v", tt.key, got, tt.wantPreOrder)
+			}
+			if tree.Search(tt.key) {
+				t.Errorf("Search(%d) after Delete = true, want false", tt.key)
+			}
+
+			want := slices.Clone(tt.wantPreOrder)
+			slices.Sort(want)
+			checkTree(t, tree, want)
+		})
+	}
+}
+
+func TestTreeDeleteRoot(t *testing.T) {
+	tests := []struct {
+		name     string
+		keys     []int
+		wantRoot *int // nil when the tree should become empty
+	}{
+		{"Only node", []int{10}, nil},
+		{"Root with left child", []int{10, 5}, ptr(5)},
+		{"Root with right child", []int{10, 15}, ptr(15)},
+		{"Root with right child that has a left child", []int{10, 5, 20, 15}, ptr(15)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree := buildTree(tt.keys...)
+			if !tree.Delete(tt.keys[0]) {
+				t.Fatalf("Delete(%d) = false, want true", tt.keys[0])
+			}
+
+			switch root := tree.root; {
+			case tt.wantRoot == nil && root != nil:
+				t.Errorf("Root key = %d, want an empty tree", root.key)
+			case tt.wantRoot != nil && (root == nil || root.key != *tt.wantRoot):
+				t.Errorf("Root = %v, want key %d", root, *tt.wantRoot)
+			}
+			if _, ok := tree.Min(); ok != (tt.wantRoot != nil) {
+				t.Errorf("Min() reported ok = %v on a tree of %d keys", ok, tree.Len())
+			}
+
+			want := slices.Clone(tt.keys[1:])
+			slices.Sort(want)
+			checkTree(t, tree, want)
+		})
+	}
+}
+
+func TestTreeDeleteAll(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	keys := r.Perm(500)
+	tree := buildTree(keys...)
+
+	// Delete in a different random order, checking the tree after every deletion
+	remaining := slices.Sorted(slices.Values(keys))
+	for _, key := range r.Perm(500) {
+		if !tree.Delete(key) {
+			t.Fatalf("Delete(%d) = false, want true", key)
+		}
+		if tree.Delete(key) {
+			t.Fatalf("Deleting %d twice = true, want false", key)
+		}
+		i, _ := slices.BinarySearch(remaining, key)
+		remaining = slices.Delete(remaining, i, i+1)
+		checkTree(t, tree, remaining)
+	}
+
+	if tree.root != nil {
+		t.Errorf("Root after deleting every key = %v, want nil", tree.root)
+	}
+	if tree.Insert(42, 42); !tree.Search(42) {
+		t.Errorf("Insert into a tree emptied by Delete failed")
+	}
+}
+
+func TestTreeMinMax(t *testing.T) {
+	tree := NewTree[int, int]()
+	if _, ok := tree.Min(); ok {
+		t.Errorf("Min() on empty tree reported a key")
+	}
+	if _, ok := tree.Max(); ok {
+		t.Errorf("Max() on empty tree reported a key")
+	}
+
+	tree = buildTree(100, 50, 150, 25, 175)
+	if min, ok := tree.Min(); !ok || min != 25 {
+		t.Errorf("Min() = (%d, %v), want (25, true)", min, ok)
+	}
+	if max, ok := tree.Max(); !ok || max != 175 {
+		t.Errorf("Max() = (%d, %v), want (175, true)", max, ok)
+	}
+}
+
+func TestTreeOrderQueries(t *testing.T) {
+	/*
+	         40
+	        /  \
+	      20    60
+	     /  \     \
+	   10    30    70
+	*/
+	tree := buildTree(40, 20, 60, 10, 30, 70)
+
+	tests := []struct {
+		key             int
+		wantFloor       *int
+		wantCeiling     *int
+		wantPredecessor *int
+		wantSuccessor   *int
+		wantRank        int
+	}{
+		{5, nil, ptr(10), nil, ptr(10), 0},
+		{10, ptr(10), ptr(10), nil, ptr(20), 0},
+		{25, ptr(20), ptr(30), ptr(20), ptr(30), 2},
+		{40, ptr(40), ptr(40), ptr(30), ptr(60), 3},
+		{50, ptr(40), ptr(60), ptr(40), ptr(60), 4},
+		{60, ptr(60), ptr(60), ptr(40), ptr(70), 4},
+		{70, ptr(70), ptr(70), ptr(60), nil, 5},
+		{99, ptr(70), nil, ptr(70), nil, 6},
+	}
+
+	for _, tt := range tests {
+		queries := []struct {
+			name string
+			fn   func(int) (int, bool)
+			want *int
+		}{
+			{"Floor", tree.Floor, tt.wantFloor},
+			{"Ceiling", tree.Ceiling, tt.wantCeiling},
+			{"Predecessor", tree.Predecessor, tt.wantPredecessor},
+			{"Successor", tree.Successor, tt.wantSuccessor},
+		}
+		for _, q := range queries {
+			got, ok := q.fn(tt.key)
+			if ok != (q.want != nil) || (ok && got != *q.want) {
+				t.Errorf("%s(%d) = (%d, %v), want %v", q.name, tt.key, got, ok, fmtPtr(q.want))
+			}
+		}
+		if got := tree.Rank(tt.key); got != tt.wantRank {
+			t.Errorf("Rank(%d) = %d, want %d", tt.key, got, tt.wantRank)
+		}
+	}
+
+	for i, want := range []int{10, 20, 30, 40, 60, 70} {
+		if got, ok := tree.Select(i); !ok || got != want {
+			t.Errorf("Select(%d) = (%d, %v), want (%d, true)", i, got, ok, want)
+		}
+	}
+	for _, i := range []int{-1, 6} {
+		if _, ok := tree.Select(i); ok {
+			t.Errorf("Select(%d) reported a key for an out-of-range index", i)
+		}
+	}
+
+	// Queries on an empty tree find nothing
+	empty := NewTree[int, int]()
+	if _, ok := empty.Floor(1); ok {
+		t.Errorf("Floor on empty tree reported a key")
+	}
+	if _, ok := empty.Successor(1); ok {
+		t.Errorf("Successor on empty tree reported a key")
+	}
+	if _, ok := empty.Select(0); ok {
+		t.Errorf("Select(0) on empty tree reported a key")
+	}
+	if empty.Rank(1) != 0 {
+		t.Errorf("Rank on empty tree = %d, want 0", empty.Rank(1))
+	}
+}
+
+func TestTreeRange(t *testing.T) {
+	tree := buildTree(40, 20, 60, 10, 30, 70)
+
+	tests := []struct {
+		name   string
+		lo, hi int
+		want   []int
+	}{
+		{"Whole tree", 0, 100, []int{10, 20, 30, 40, 60, 70}},
+		{"Inclusive bounds", 20, 60, []int{20, 30, 40, 60}},
+		{"Bounds between keys", 25, 65, []int{30, 40, 60}},
+		{"Single key", 30, 30, []int{30}},
+		{"Gap between keys", 41, 59, []int{}},
+		{"Below the minimum", -10, 5, []int{}},
+		{"Above the maximum", 71, 100, []int{}},
+		{"Reversed bounds", 60, 20, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tree.Range(tt.lo, tt.hi); !slices.Equal(got, tt.want) {
+				t.Errorf("Range(%d, %d) = %v, want %v", tt.lo, tt.hi, got, tt.want)
+			}
+			if got := tree.CountRange(tt.lo, tt.hi); got != len(tt.want) {
+				t.Errorf("CountRange(%d, %d) = %d, want %d", tt.lo, tt.hi, got, len(tt.want))
+			}
+		})
+	}
+}
+
+// TestTreeRangeOfDegenerateTree checks that Range walks a deep chain without recursing
+func TestTreeRangeOfDegenerateTree(t *testing.T) {
+	// Link a right-leaning chain with keys 0..n-1 by hand; inserting sorted keys takes O(n²)
+	const n = 1_000_000
+	tree := NewTree[int, int]()
+	link := &tree.root
+	for i := 0; i < n; i++ {
+		*link = &node[int, int]{key: i, value: i, size: n - i}
+		link = &(*link).right
+	}
+	tree.size = n
+
+	got := tree.Range(n-5, n+5)
+	if want := []int{n - 5, n - 4, n - 3, n - 2, n - 1}; !slices.Equal(got, want) {
+		t.Errorf("Range(%d, %d) = %v, want %v", n-5, n+5, got, want)
+	}
+	if got := tree.Range(0, n); len(got) != n || !slices.IsSorted(got) {
+		t.Errorf("Range over the whole chain returned %d keys, sorted: %v", len(got), slices.IsSorted(got))
+	}
+	if got := tree.CountRange(10, n-11); got != n-20 {
+		t.Errorf("CountRange(10, %d) = %d, want %d", n-11, got, n-20)
+	}
+}
+
+func TestTreeRankSelectAfterDeletes(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	tree := buildTree(r.Perm(300)...)
+	for _, key := range r.Perm(300)[:150] {
+		tree.Delete(key)
+	}
+
+	// Every query must agree with a binary search over the sorted keys
+	keys := tree.InOrderTraversal()
+	for i, key := range keys {
+		if got := tree.Rank(key); got != i {
+			t.Errorf("Rank(%d) = %d, want %d", key, got, i)
+		}
+		if got, ok := tree.Select(i); !ok || got != key {
+			t.Errorf("Select(%d) = (%d, %v), want (%d, true)", i, got, ok, key)
+		}
+	}
+	for key := -1; key <= 300; key++ {
+		i, found := slices.BinarySearch(keys, key)
+		if got := tree.Rank(key); got != i {
+			t.Errorf("Rank(%d) = %d, want %d", key, got, i)
+		}
+		if !found {
+			if got, ok := tree.Ceiling(key); ok != (i < len(keys)) || (ok && got != keys[i]) {
+				t.Errorf("Ceiling(%d) = (%d, %v)", key, got, ok)
+			}
+		}
+	}
+}
+
+// fmtPtr formats an optional value from a test table
+func fmtPtr(v *int) string {
+	if v == nil {
+		return "none"
+	}
+	return fmt.Sprintf("(%d, true)", *v)
+}
+
+// ptr returns a pointer to v, for optional fields in test tables
+func ptr(v int) *int {
+	return &v
+}
+
+func TestTreeGetAndUpdate(t *testing.T) {
+	tree := NewTree[string, int]()
+	for i, key := range []string{"m", "c", "x", "a"} {
+		tree.Insert(key, i)
+	}
+
+	// Inserting an existing key replaces its value instead of dropping it
+	if tree.Insert("c", 100) {
+		t.Errorf("Insert of an existing key = true, want false")
+	}
+	if tree.Len() != 4 {
+		t.Errorf("Len() after updating a key = %d, want 4", tree.Len())
+	}
+
+	tests := []struct {
+		key       string
+		wantValue int
+		wantFound bool
+	}{
+		{"m", 0, true},
+		{"c", 100, true},
+		{"x", 2, true},
+		{"a", 3, true},
+		{"b", 0, false},
+	}
+	for _, tt := range tests {
+		if value, ok := tree.Get(tt.key); value != tt.wantValue || ok != tt.wantFound {
+			t.Errorf("Get(%q) = (%d, %v), want (%d, %v)", tt.key, value, ok, tt.wantValue, tt.wantFound)
+		}
+	}
+
+	// Deleting a node with two children moves the successor's value along with its key
+	tree.Delete("m")
+	if value, ok := tree.Get("x"); !ok || value != 2 {
+		t.Errorf("Get(%q) after deleting its parent = (%d, %v), want (2, true)", "x", value, ok)
+	}
+
+	var pairs []string
+	for key, value := range tree.All() {
+		pairs = append(pairs, fmt.Sprintf("%s=%d", key, value))
+	}
+	if want := []string{"a=3", "c=100", "x=2"}; !slices.Equal(pairs, want) {
+		t.Errorf("All() = %v, want %v", pairs, want)
+	}
+}
+
+// event is a composite key ordered by tenant, then by timestamp
+type event struct {
+	tenant    string
+	timestamp int
+}
+
+func compareEvents(a, b event) int {
+	return cmp.Or(cmp.Compare(a.tenant, b.tenant), cmp.Compare(a.timestamp, b.timestamp))
+}
+
+func TestTreeComparator(t *testing.T) {
+	t.Run("Reverse order", func(t *testing.T) {
+		tree := NewTree[int, string](WithComparator(func(a, b int) int { return cmp.Compare(b, a) }))
+		for _, key := range []int{20, 10, 30, 40} {
+			tree.Insert(key, "")
+		}
+		if got := tree.InOrderTraversal(); !slices.Equal(got, []int{40, 30, 20, 10}) {
+			t.Errorf("InOrderTraversal() = %v, want [40 30 20 10]", got)
+		}
+		if min, _ := tree.Min(); min != 40 {
+			t.Errorf("Min() = %d, want 40, the first key in comparator order", min)
+		}
+		if got := tree.Range(35, 15); !slices.Equal(got, []int{30, 20}) {
+			t.Errorf("Range(35, 15) = %v, want [30 20]", got)
+		}
+	})
+
+	t.Run("Composite keys", func(t *testing.T) {
+		tree := NewTreeFunc[event, string](compareEvents)
+		events := []event{{"beta", 2}, {"alpha", 5}, {"beta", 1}, {"alpha", 3}, {"gamma", 1}}
+		for _, e := range events {
+			tree.Insert(e, fmt.Sprintf("%s@%d", e.tenant, e.timestamp))
+		}
+		tree.Insert(event{"alpha", 3}, "updated")
+
+		want := []event{{"alpha", 3}, {"alpha", 5}, {"beta", 1}, {"beta", 2}, {"gamma", 1}}
+		if got := tree.InOrderTraversal(); !slices.Equal(got, want) {
+			t.Errorf("InOrderTraversal() = %v, want %v", got, want)
+		}
+		if value, _ := tree.Get(event{"alpha", 3}); value != "updated" {
+			t.Errorf("Get({alpha 3}) = %q, want %q", value, "updated")
+		}
+
+		// All of one tenant's events, whatever their timestamps
+		got := tree.Range(event{"beta", math.MinInt}, event{"beta", math.MaxInt})
+		if want := []event{{"beta", 1}, {"beta", 2}}; !slices.Equal(got, want) {
+			t.Errorf("Range over tenant beta = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("Nil comparator", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("NewTreeFunc with a nil comparator did not panic")
+			}
+		}()
+		NewTreeFunc[event, int](nil)
+	})
+}
+
+func TestTreeAllFrom(t *testing.T) {
+	tree := buildTree(40, 20, 60, 10, 30, 50, 70)
+
+	tests := []struct {
+		key  int
+		want []int
+	}{
+		{0, []int{10, 20, 30, 40, 50, 60, 70}},
+		{30, []int{30, 40, 50, 60, 70}},
+		{45, []int{50, 60, 70}},
+		{70, []int{70}},
+		{71, nil},
+	}
+	for _, tt := range tests {
+		var keys []int
+		for key, value := range tree.AllFrom(tt.key) {
+			if value != key {
+				t.Errorf("AllFrom(%d) yielded (%d, %d), want the value %d", tt.key, key, value, key)
+			}
+			keys = append(keys, key)
+		}
+		if !slices.Equal(keys, tt.want) {
+			t.Errorf("AllFrom(%d) = %v, want %v", tt.key, keys, tt.want)
+		}
+	}
+
+	// The iterator is lazy: stopping after two keys does not visit the rest
+	visited := 0
+	tree.root.inOrderFrom(25, tree.compare, func(*node[int, int]) bool {
+		visited++
+		return visited < 2
+	})
+	if visited != 2 {
+		t.Errorf("inOrderFrom visited %d nodes after being asked to stop at 2", visited)
+	}
+
+	if got := tree.LevelOrderTraversal(); !slices.Equal(got, []int{40, 20, 60, 10, 30, 50, 70}) {
+		t.Errorf("LevelOrderTraversal() = %v, want [40 20 60 10 30 50 70]", got)
+	}
+}
+
+func TestTreeTraversalIterators(t *testing.T) {
+	tree := buildTree(40, 20, 60, 10, 30, 50, 70)
+
+	tests := []struct {
+		name string
+		got  []int
+		want []int
+	}{
+		{"PreOrder", slices.Collect(tree.PreOrder()), []int{40, 20, 10, 30, 60, 50, 70}},
+		{"PostOrder", slices.Collect(tree.PostOrder()), []int{10, 30, 20, 50, 70, 60, 40}},
+		{"LevelOrder", slices.Collect(tree.LevelOrder()), []int{40, 20, 60, 10, 30, 50, 70}},
+	}
+	for _, tt := range tests {
+		if !slices.Equal(tt.got, tt.want) {
+			t.Errorf("%s() = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+
+	// Breaking out of the loop stops the walk
+	var keys []int
+	for key := range tree.LevelOrder() {
+		keys = append(keys, key)
+		if len(keys) == 3 {
+			break
+		}
+	}
+	if !slices.Equal(keys, []int{40, 20, 60}) {
+		t.Errorf("LevelOrder() stopped after 3 keys = %v, want [40 20 60]", keys)
+	}
+
+	if got := slices.Collect(NewTree[int, int]().PreOrder()); got != nil {
+		t.Errorf("PreOrder() of an empty tree = %v, want nothing", got)
+	}
+}
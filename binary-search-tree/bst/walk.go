@@ -0,0 +1,123 @@
+package bst
+
+// binaryNode is implemented by *Node and *node[K, V], so that a single set of traversals serves
+// the legacy int tree as well as Tree and AVLTree
+// N is the node pointer type itself, and its zero value (nil) is the empty subtree
+type binaryNode[N any] interface {
+	comparable
+	children() (left, right N)
+}
+
+// children returns the node's left and right subtrees
+func (n *Node) children() (*Node, *Node) {
+	return n.Left, n.Right
+}
+
+// children returns the node's left and right subtrees
+func (n *node[K, V]) children() (*node[K, V], *node[K, V]) {
+	return n.left, n.right
+}
+
+// inOrder visits the subtree in order and reports false once visit asks to stop
+// Like all the walks below, it keeps its path on an explicit stack instead of recursing, so a
+// degenerate tree cannot overflow the goroutine stack
+func inOrder[N binaryNode[N]](n N, visit func(N) bool) bool {
+	var empty N
+	var stack []N
+	for ; n != empty; n, _ = n.children() {
+		stack = append(stack, n)
+	}
+	return ascend(stack, visit)
+}
+
+// ascend pops the nodes off the stack in order, visiting each one and then stacking the left
+// spine of its right subtree, and reports false once visit asks to stop
+func ascend[N binaryNode[N]](stack []N, visit func(N) bool) bool {
+	var empty N
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if !visit(n) {
+			return false
+		}
+		_, m := n.children()
+		for ; m != empty; m, _ = m.children() {
+			stack = append(stack, m)
+		}
+	}
+	return true
+}
+
+// preOrder visits the subtree in pre-order and reports false once visit asks to stop
+func preOrder[N binaryNode[N]](n N, visit func(N) bool) bool {
+	var empty N
+	if n == empty {
+		return true
+	}
+	stack := []N{n}
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if !visit(n) {
+			return false
+		}
+		// Push the right child first so the left subtree is visited first
+		left, right := n.children()
+		if right != empty {
+			stack = append(stack, right)
+		}
+		if left != empty {
+			stack = append(stack, left)
+		}
+	}
+	return true
+}
+
+// postOrder visits the subtree in post-order and reports false once visit asks to stop
+func postOrder[N binaryNode[N]](n N, visit func(N) bool) bool {
+	var empty N
+	var stack []N
+	last := empty // The most recently visited node
+	for n != empty || len(stack) > 0 {
+		if n != empty {
+			stack = append(stack, n)
+			n, _ = n.children()
+			continue
+		}
+		top := stack[len(stack)-1]
+		if _, right := top.children(); right != empty && right != last {
+			n = right // The right subtree comes before the node itself
+			continue
+		}
+		if !visit(top) {
+			return false
+		}
+		last = top
+		stack = stack[:len(stack)-1]
+	}
+	return true
+}
+
+// levelOrder visits the subtree level by level and reports false once visit asks to stop
+func levelOrder[N binaryNode[N]](n N, visit func(N) bool) bool {
+	var empty N
+	if n == empty {
+		return true
+	}
+	queue := []N{n}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		if !visit(n) {
+			return false
+		}
+		left, right := n.children()
+		if left != empty {
+			queue = append(queue, left)
+		}
+		if right != empty {
+			queue = append(queue, right)
+		}
+	}
+	return true
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"cmp"
 	"fmt"
 
 	"github.com/phihdn/go-data-structures/binary-search-tree/bst"
@@ -28,8 +29,94 @@ func main() {
 	fmt.Println("In-order traversal (sorted):", tree.InOrderTraversal())
 	fmt.Println("Pre-order traversal:", tree.PreOrderTraversal())
 	fmt.Println("Post-order traversal:", tree.PostOrderTraversal())
+	fmt.Println("Level-order traversal:", tree.LevelOrderTraversal())
+
+	// Lazy in-order iteration starting from a key that need not be in the tree
+	fmt.Print("Keys from 60 up to the first one above 120:")
+	for key := range tree.AllFrom(60) {
+		fmt.Print(" ", key)
+		if key > 120 {
+			break
+		}
+	}
+	fmt.Println()
 
 	// Display min and max values
 	fmt.Println("\nMin value:", tree.Min())
 	fmt.Println("Max value:", tree.Max())
+
+	// Inspect the shape of the tree
+	fmt.Println("\nTree shape:")
+	fmt.Println(tree)
+	fmt.Println("Height:", tree.Height(), "Size:", tree.Size(), "Valid:", tree.IsValid(), "Balanced:", tree.IsBalanced())
+
+	// A clone can be changed without touching the original
+	clone := tree.Clone()
+	clone.Insert(200)
+	clone.Insert(250)
+	fmt.Println("\nClone after inserting 200 and 250:")
+	fmt.Println(clone)
+	fmt.Println("Balanced:", clone.IsBalanced(), "Equal to the original:", clone.Equal(tree))
+
+	// Deleting keys, including the root, through a Tree that owns the root pointer
+	fmt.Println("\nDeleting from a Tree:")
+	owned := bst.NewTree[int, string]()
+	for _, key := range []int{100, 50, 150, 25, 75, 125, 175} {
+		owned.Insert(key, fmt.Sprintf("value-%d", key))
+	}
+	fmt.Println("Delete 25 (leaf):", owned.Delete(25))
+	fmt.Println("Delete 100 (root, two children):", owned.Delete(100))
+	fmt.Println("Delete 200 (missing):", owned.Delete(200))
+	fmt.Println("In-order after deletes:", owned.InOrderTraversal())
+
+	// Inserting an existing key updates its value
+	owned.Insert(150, "updated")
+	value150, _ := owned.Get(150)
+	fmt.Println("Get(150) after re-inserting it:", value150)
+
+	// Composite keys ordered by a custom comparator: by tenant, then by timestamp
+	type event struct {
+		tenant    string
+		timestamp int
+	}
+	events := bst.NewTreeFunc[event, string](func(a, b event) int {
+		return cmp.Or(cmp.Compare(a.tenant, b.tenant), cmp.Compare(a.timestamp, b.timestamp))
+	})
+	events.Insert(event{"beta", 20}, "deploy")
+	events.Insert(event{"alpha", 30}, "login")
+	events.Insert(event{"beta", 10}, "build")
+	events.Insert(event{"alpha", 10}, "signup")
+	fmt.Println("\nEvents in (tenant, timestamp) order:")
+	for e, name := range events.All() {
+		fmt.Printf("  %s at %d: %s\n", e.tenant, e.timestamp, name)
+	}
+
+	// A self-balancing tree stays shallow even when keys arrive in sorted order
+	fmt.Println("\nAVL tree with keys 1..1000 inserted in order:")
+	avl := bst.NewAVL[int, string]()
+	for key := 1; key <= 1000; key++ {
+		avl.Insert(key, fmt.Sprintf("value-%d", key))
+	}
+	value, _ := avl.Get(500)
+	fmt.Println("Height:", avl.Height(), "for", avl.Len(), "keys") // A plain Node would be 1000 levels deep
+	fmt.Println("Get(500):", value)
+
+	// Ordered-map queries, answered from the subtree size stored in every node
+	fmt.Println("\nOrdered queries on the AVL tree (keys 1..1000):")
+	floor, _ := avl.Floor(2000)
+	successor, _ := avl.Successor(500)
+	fmt.Println("Floor(2000):", floor, "Successor(500):", successor)
+	fmt.Println("Rank(500):", avl.Rank(500))
+	median, _ := avl.Select(avl.Len() / 2)
+	fmt.Println("Median (Select(Len/2)):", median)
+	fmt.Println("Range(495, 505):", avl.Range(495, 505))
+	fmt.Println("CountRange(100, 199):", avl.CountRange(100, 199))
+
+	// Deletes rebalance on the way back up too
+	fmt.Println("\nDeleting keys 1..900 from the AVL tree:")
+	for key := 1; key <= 900; key++ {
+		avl.Delete(key)
+	}
+	minKey, _ := avl.Min()
+	fmt.Println("Height:", avl.Height(), "for", avl.Len(), "keys, Min():", minKey)
 }
@@ -1,6 +1,9 @@
 package graph
 
-import "fmt"
+import (
+	"fmt"
+	"iter"
+)
 
 // Vertex represents a node in the graph with a key and list of adjacent vertices
 type Vertex struct {
@@ -105,6 +108,33 @@ func (g *Graph) GetAllVertices() []int {
 	return keys
 }
 
+// All returns an iterator over the keys of all vertices in the graph, in insertion order
+// The graph must not be modified while iterating
+func (g *Graph) All() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for _, v := range g.Vertices {
+			if !yield(v.Key) {
+				return
+			}
+		}
+	}
+}
+
+// Edges returns an iterator over all directed edges in the graph as (from, to) key pairs
+// Edges are produced grouped by their source vertex, in insertion order
+// The graph must not be modified while iterating
+func (g *Graph) Edges() iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		for _, v := range g.Vertices {
+			for _, adj := range v.Adjacent {
+				if !yield(v.Key, adj.Key) {
+					return
+				}
+			}
+		}
+	}
+}
+
 // containsVertex checks if a vertex with the given key exists in the slice of vertices
 func containsVertex(vertices []*Vertex, key int) bool {
 	for _, v := range vertices {
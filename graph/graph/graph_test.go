@@ -2,6 +2,7 @@ package graph
 
 import (
 	"reflect"
+	"slices"
 	"testing"
 )
 
@@ -385,3 +386,30 @@ func TestDFS(t *testing.T) {
 		})
 	}
 }
+
+func TestIterators(t *testing.T) {
+	g := NewGraph()
+	for _, key := range []int{3, 1, 2} {
+		g.AddVertex(key)
+	}
+	g.AddEdge(3, 1)
+	g.AddEdge(3, 2)
+	g.AddEdge(2, 1)
+
+	if result := slices.Collect(g.All()); !slices.Equal(result, []int{3, 1, 2}) {
+		t.Errorf("All() = %v, expected [3 1 2]", result)
+	}
+
+	var edges [][2]int
+	for from, to := range g.Edges() {
+		edges = append(edges, [2]int{from, to})
+	}
+	expected := [][2]int{{3, 1}, {3, 2}, {2, 1}}
+	if !reflect.DeepEqual(edges, expected) {
+		t.Errorf("Edges() = %v, expected %v", edges, expected)
+	}
+
+	if result := slices.Collect(NewGraph().All()); len(result) != 0 {
+		t.Errorf("All() on empty graph = %v, expected no vertices", result)
+	}
+}
@@ -2,7 +2,9 @@ package main
 
 import (
 	"fmt"
+	"slices"
 	"strings"
+	"sync"
 
 	"github.com/phihdn/go-data-structures/hash-table/hashtable"
 )
@@ -30,7 +32,9 @@ func main() {
 	// Attempt to insert duplicate
 	fmt.Println("\n4. Attempting to insert a duplicate")
 	fmt.Print("   Inserting 'ERIC' again... ")
-	myHashTable.Insert("ERIC") // This should print "Key already exists: ERIC"
+	if err := myHashTable.Insert("ERIC"); err != nil {
+		fmt.Println(err) // insert ERIC: key already exists
+	}
 
 	// Search operation demonstration
 	fmt.Println("\n5. Searching for words in the hash table")
@@ -42,16 +46,16 @@ func main() {
 	fmt.Println("\n6. Deleting words from the hash table")
 
 	// Delete an existing word
-	deleted := myHashTable.Delete("ERIC")
+	_, deleted := myHashTable.Delete("ERIC")
 	fmt.Printf("   Deleted 'ERIC': %t\n", deleted)
 	fmt.Printf("   Search for 'ERIC' after deletion: %t\n", myHashTable.Search("ERIC"))
 
 	// Try to delete a non-existent word
-	deleted = myHashTable.Delete("NONEXISTENT")
+	_, deleted = myHashTable.Delete("NONEXISTENT")
 	fmt.Printf("   Deleted 'NONEXISTENT' (not inserted): %t\n", deleted)
 
 	// Try to delete an already deleted word
-	deleted = myHashTable.Delete("ERIC")
+	_, deleted = myHashTable.Delete("ERIC")
 	fmt.Printf("   Deleted 'ERIC' again (already deleted): %t\n", deleted)
 
 	// Demonstrating hash collisions
@@ -130,5 +134,86 @@ func main() {
 		}
 	}
 
+	// Key/value storage demonstration
+	fmt.Println("\n8. Storing values with keys")
+	ages := hashtable.New[string, int]()
+	ages.Put("ERIC", 10)
+	ages.Put("KENNY", 9)
+	ages.Update("ERIC", func(age int) int { return age + 1 }) // Birthday
+	ericAge, _ := ages.Get("ERIC")
+	fmt.Printf("   ERIC is %d\n", ericAge)
+	fmt.Printf("   CARTMAN is %d (default)\n", ages.GetOrDefault("CARTMAN", -1))
+	oldAge, _ := ages.Delete("KENNY")
+	fmt.Printf("   Deleted KENNY (was %d), %d key(s) left\n", oldAge, ages.Len())
+
+	// Pluggable hash functions demonstration
+	fmt.Println("\n9. Choosing a hash function")
+	hashers := []struct {
+		name   string
+		hasher hashtable.Hasher[string]
+	}{
+		{"MapHash", hashtable.MapHash[string]{}},
+		{"FNV-1a", hashtable.FNV1a[string]{}},
+		{"xxHash", hashtable.XXHash[string]{}},
+	}
+	for _, h := range hashers {
+		table := hashtable.NewWithHasher[string, struct{}](h.hasher)
+		for _, word := range listOfWords {
+			table.Insert(word)
+		}
+		fmt.Printf("   %-8s 'listen' -> %#016x, 'silent' -> %#016x, %d words stored\n",
+			h.name, h.hasher.Hash("listen"), h.hasher.Hash("silent"), table.Len())
+	}
+
+	// Open-addressing backend demonstration
+	fmt.Println("\n10. Using the open-addressing backend")
+	var open hashtable.Table[string, int] = hashtable.NewOpen[string, int]()
+	for i, word := range listOfWords {
+		open.Put(word, i)
+	}
+	open.Delete("KENNY")
+	kyle, _ := open.Get("KYLE")
+	fmt.Printf("   %d words stored, KYLE -> %d, KENNY found: %t\n", open.Len(), kyle, open.Search("KENNY"))
+
+	// Concurrent table demonstration
+	fmt.Println("\n11. Sharing a table between goroutines")
+	visits := hashtable.NewConcurrent[string, int]()
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, word := range listOfWords {
+				visits.Update(word, func(n int) int { return n + 1 })
+			}
+		}()
+	}
+	wg.Wait()
+	total := 0
+	visits.Range(func(_ string, n int) bool {
+		total += n
+		return true
+	})
+	fmt.Printf("   4 goroutines counted %d visits over %d words\n", total, visits.Len())
+
+	// Iteration and statistics demonstration
+	fmt.Println("\n12. Listing keys and inspecting the bucket distribution")
+	remaining := myHashTable.Keys()
+	slices.Sort(remaining)
+	fmt.Println("   Keys left in the first table:", strings.Join(remaining, ", "))
+
+	big := hashtable.New[int, int]()
+	for i := 0; i < 10_000; i++ {
+		big.Put(i, i*i)
+	}
+	stats := big.Stats()
+	fmt.Printf("   %d keys in %d buckets, load factor %.2f, longest chain %d\n",
+		stats.Keys, stats.Buckets, stats.LoadFactor, stats.LongestChain)
+	for length, buckets := range stats.ChainLengths {
+		fmt.Printf("   %d bucket(s) hold %d key(s)\n", buckets, length)
+	}
+	big.Clear()
+	fmt.Printf("   After Clear: %d keys\n", big.Len())
+
 	fmt.Println("\n=== HASH TABLE DEMONSTRATION COMPLETE ===")
 }
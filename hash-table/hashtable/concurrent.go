@@ -0,0 +1,254 @@
+package hashtable
+
+import (
+	"iter"
+	"math/bits"
+	"slices"
+	"sync"
+)
+
+// DefaultShards is the number of shards of a ConcurrentHashTable created without WithShards
+const DefaultShards = 32
+
+// ConcurrentHashTable is a hash table that is safe for use by multiple goroutines
+// Keys are spread over a fixed number of shards, each of which is a HashTable guarded by
+// its own RWMutex. Goroutines working on different shards never wait for each other,
+// and any number of readers can use the same shard at once
+type ConcurrentHashTable[K comparable, V any] struct {
+	shards []shard[K, V] // Length is a power of two
+	shift  uint          // A key's shard is its hash shifted right by this many bits
+	hasher Hasher[K]     // Hash function used to pick a shard
+}
+
+// shard is one independently locked part of a ConcurrentHashTable
+type shard[K comparable, V any] struct {
+	mu    sync.RWMutex
+	table *HashTable[K, V]
+}
+
+// ConcurrentHashTable can be used wherever a Table is expected
+var _ Table[string, int] = (*ConcurrentHashTable[string, int])(nil)
+
+// NewConcurrent creates a new ConcurrentHashTable configured by the given options, hashing keys with MapHash
+// It panics if the load-factor thresholds are invalid
+func NewConcurrent[K comparable, V any](opts ...Option) *ConcurrentHashTable[K, V] {
+	return NewConcurrentWithHasher[K, V](MapHash[K]{}, opts...)
+}
+
+// NewConcurrentWithHasher creates a new ConcurrentHashTable that hashes keys with h
+// WithCapacity is split evenly between the shards
+// It panics if the load-factor thresholds are invalid
+func NewConcurrentWithHasher[K comparable, V any](h Hasher[K], opts ...Option) *ConcurrentHashTable[K, V] {
+	c := buildConfig(opts)
+	n := DefaultShards
+	if c.shards > 0 {
+		n = 1 << bits.Len(uint(c.shards-1)) // Round up to a power of two
+	}
+
+	ht := &ConcurrentHashTable[K, V]{
+		shards: make([]shard[K, V], n),
+		shift:  uint(64 - bits.TrailingZeros(uint(n))),
+		hasher: h,
+	}
+	shardOpts := slices.Concat(opts, []Option{WithCapacity((c.capacity + n - 1) / n)})
+	for i := range ht.shards {
+		ht.shards[i].table = NewWithHasher[K, V](h, shardOpts...)
+	}
+	return ht
+}
+
+// Insert adds a key to the hash table with the zero value, if it is not already present
+// Time complexity: O(1) on average
+// Returns:
+//   - error: nil if the key was added, or an error wrapping ErrKeyExists if it was already present
+func (ht *ConcurrentHashTable[K, V]) Insert(key K) error {
+	s := ht.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.table.Insert(key)
+}
+
+// Upsert stores the value for the key, replacing any existing value
+// Time complexity: O(1) on average
+// Returns:
+//   - bool: True if the key was newly inserted, false if an existing value was replaced
+func (ht *ConcurrentHashTable[K, V]) Upsert(key K, value V) bool {
+	s := ht.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.table.Upsert(key, value)
+}
+
+// Put stores the value for the key, replacing any existing value
+// Time complexity: O(1) on average
+func (ht *ConcurrentHashTable[K, V]) Put(key K, value V) {
+	ht.Upsert(key, value)
+}
+
+// Search returns true if the key exists in the hash table
+// Time complexity: O(1) on average
+func (ht *ConcurrentHashTable[K, V]) Search(key K) bool {
+	s := ht.shardFor(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.table.Search(key)
+}
+
+// Get returns the value stored for the key
+// Time complexity: O(1) on average
+// Returns:
+//   - V: The stored value, or the zero value if the key is absent
+//   - bool: True if the key was found
+func (ht *ConcurrentHashTable[K, V]) Get(key K) (V, bool) {
+	s := ht.shardFor(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.table.Get(key)
+}
+
+// GetOrDefault returns the value stored for the key, or def if the key is absent
+// Time complexity: O(1) on average
+func (ht *ConcurrentHashTable[K, V]) GetOrDefault(key K, def V) V {
+	if value, ok := ht.Get(key); ok {
+		return value
+	}
+	return def
+}
+
+// Update replaces the value for the key with fn applied to it and returns the new value
+// If the key is absent, fn receives the zero value and the result is inserted
+// The read, fn and the write happen atomically under the shard's lock, so concurrent counters
+// never lose an increment. fn must not use the table
+// Time complexity: O(1) on average, plus the cost of fn
+func (ht *ConcurrentHashTable[K, V]) Update(key K, fn func(V) V) V {
+	s := ht.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.table.Update(key, fn)
+}
+
+// Delete removes a key from the hash table
+// Time complexity: O(1) on average
+// Returns:
+//   - V: The value that was stored for the key, or the zero value if the key was absent
+//   - bool: True if the key was found and deleted
+func (ht *ConcurrentHashTable[K, V]) Delete(key K) (V, bool) {
+	s := ht.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.table.Delete(key)
+}
+
+// Len returns the number of keys stored in the hash table
+// Shards are counted one after another, so the result may be stale if other goroutines are writing
+// Time complexity: O(number of shards)
+func (ht *ConcurrentHashTable[K, V]) Len() int {
+	n := 0
+	for i := range ht.shards {
+		s := &ht.shards[i]
+		s.mu.RLock()
+		n += s.table.Len()
+		s.mu.RUnlock()
+	}
+	return n
+}
+
+// Keys returns the keys stored in the hash table, in no particular order
+// Like Range, the result is not a snapshot if other goroutines are writing
+// Time complexity: O(n + b) where n is the number of keys and b the number of buckets
+func (ht *ConcurrentHashTable[K, V]) Keys() []K {
+	var keys []K
+	for i := range ht.shards {
+		s := &ht.shards[i]
+		s.mu.RLock()
+		keys = append(keys, s.table.Keys()...)
+		s.mu.RUnlock()
+	}
+	return keys
+}
+
+// All returns an iterator over the keys and values in the hash table, in no particular order
+// It has the same guarantees as Range: the loop body may use the table
+// Time complexity: O(n + b) for a full iteration, where b is the number of buckets
+func (ht *ConcurrentHashTable[K, V]) All() iter.Seq2[K, V] {
+	return ht.Range
+}
+
+// Clear removes every key, one shard at a time
+// Time complexity: O(number of shards)
+func (ht *ConcurrentHashTable[K, V]) Clear() {
+	for i := range ht.shards {
+		s := &ht.shards[i]
+		s.mu.Lock()
+		s.table.Clear()
+		s.mu.Unlock()
+	}
+}
+
+// Stats reports the distribution of keys over the buckets of all shards
+// Each shard is measured under its read lock, one after another. LoadFactor has the same meaning
+// as for a HashTable: keys per bucket of the current bucket arrays, old buckets left out
+// Time complexity: O(n + b) where n is the number of keys and b the number of buckets
+func (ht *ConcurrentHashTable[K, V]) Stats() Stats {
+	var total Stats
+	current := 0 // Buckets in the current arrays of all shards
+	for i := range ht.shards {
+		s := &ht.shards[i]
+		s.mu.RLock()
+		st := s.table.Stats()
+		current += len(s.table.buckets)
+		s.mu.RUnlock()
+
+		total.Keys += st.Keys
+		total.Buckets += st.Buckets
+		total.LongestChain = max(total.LongestChain, st.LongestChain)
+		total.Rehashing = total.Rehashing || st.Rehashing
+		for n, count := range st.ChainLengths {
+			for len(total.ChainLengths) <= n {
+				total.ChainLengths = append(total.ChainLengths, 0)
+			}
+			total.ChainLengths[n] += count
+		}
+	}
+	if current > 0 {
+		total.LoadFactor = float64(total.Keys) / float64(current)
+	}
+	return total
+}
+
+// Range calls fn for every key and value in the table, in no particular order, until fn returns false
+// Each shard is copied under its read lock and fn runs after the lock is released,
+// so fn may use the table. Range is not a snapshot of the whole table: writes to a
+// shard that has not been visited yet may or may not be seen
+// Time complexity: O(n) where n is the number of keys
+func (ht *ConcurrentHashTable[K, V]) Range(fn func(key K, value V) bool) {
+	type entry struct {
+		key   K
+		value V
+	}
+
+	var entries []entry
+	for i := range ht.shards {
+		s := &ht.shards[i]
+		entries = entries[:0]
+		s.mu.RLock()
+		s.table.forEach(func(k K, v V) bool {
+			entries = append(entries, entry{k, v})
+			return true
+		})
+		s.mu.RUnlock()
+
+		for _, e := range entries {
+			if !fn(e.key, e.value) {
+				return
+			}
+		}
+	}
+}
+
+// shardFor returns the shard that holds, or would hold, the key
+// The shard is picked from the top bits of the hash, while the shard's own table uses the
+// hash modulo its bucket count, so keys of one shard still spread over all its buckets
+func (ht *ConcurrentHashTable[K, V]) shardFor(key K) *shard[K, V] {
+	return &ht.shards[ht.hasher.Hash(key)>>ht.shift]
+}
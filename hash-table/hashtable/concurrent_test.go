@@ -0,0 +1,280 @@
+package hashtable
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"sync"
+	"testing"
+)
+
+func TestConcurrentHashTable(t *testing.T) {
+	ht := NewConcurrent[string, int]()
+
+	keys := []string{"ERIC", "KENNY", "KYLE", "STAN", "BUTTERS", "RANDY"}
+	for i, key := range keys {
+		if inserted := ht.Upsert(key, i); !inserted {
+			t.Errorf("Upsert(%q) = false, want true", key)
+		}
+	}
+	if err := ht.Insert("ERIC"); !errors.Is(err, ErrKeyExists) {
+		t.Errorf("Insert of an existing key returned %v, want ErrKeyExists", err)
+	}
+	ht.Update("KENNY", func(v int) int { return v + 10 })
+
+	for i, key := range keys {
+		want := i
+		if key == "KENNY" {
+			want += 10
+		}
+		if got, ok := ht.Get(key); !ok || got != want {
+			t.Errorf("Get(%q) = (%d, %v), want (%d, true)", key, got, ok, want)
+		}
+	}
+	if got := ht.GetOrDefault("CARTMAN", -1); got != -1 {
+		t.Errorf("GetOrDefault(%q) = %d, want -1", "CARTMAN", got)
+	}
+
+	if value, deleted := ht.Delete("KYLE"); !deleted || value != 2 {
+		t.Errorf("Delete(%q) = (%d, %v), want (2, true)", "KYLE", value, deleted)
+	}
+	if ht.Search("KYLE") {
+		t.Errorf("Expected key 'KYLE' to be deleted from hash table")
+	}
+	if ht.Len() != len(keys)-1 {
+		t.Errorf("Len() = %d, want %d", ht.Len(), len(keys)-1)
+	}
+}
+
+func TestConcurrentHashTableShards(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       []Option
+		wantShards int
+	}{
+		{"Default shards", nil, DefaultShards},
+		{"Single shard", []Option{WithShards(1)}, 1},
+		{"Rounded up to a power of two", []Option{WithShards(5)}, 8},
+		{"Power of two", []Option{WithShards(64)}, 64},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ht := NewConcurrent[int, int](tt.opts...)
+			if len(ht.shards) != tt.wantShards {
+				t.Fatalf("Shard count = %d, want %d", len(ht.shards), tt.wantShards)
+			}
+
+			// Every shard gets used and every key stays reachable
+			const n = 10_000
+			for i := 0; i < n; i++ {
+				ht.Put(i, i)
+			}
+			for i := range ht.shards {
+				if ht.shards[i].table.Len() == 0 {
+					t.Errorf("Shard %d of %d is empty after %d inserts", i, len(ht.shards), n)
+				}
+			}
+			for i := 0; i < n; i++ {
+				if !ht.Search(i) {
+					t.Fatalf("Search(%d) = false after insert", i)
+				}
+			}
+		})
+	}
+}
+
+func TestConcurrentHashTableRange(t *testing.T) {
+	ht := NewConcurrent[int, int]()
+	const n = 1000
+	for i := 0; i < n; i++ {
+		ht.Put(i, i*i)
+	}
+
+	// Every entry is visited once
+	seen := make(map[int]bool)
+	ht.Range(func(k, v int) bool {
+		if seen[k] || v != k*k {
+			t.Errorf("Range visited (%d, %d), already seen: %v", k, v, seen[k])
+		}
+		seen[k] = true
+		return true
+	})
+	if len(seen) != n {
+		t.Errorf("Range visited %d keys, want %d", len(seen), n)
+	}
+
+	// Returning false stops the walk
+	visited := 0
+	ht.Range(func(int, int) bool {
+		visited++
+		return visited < 10
+	})
+	if visited != 10 {
+		t.Errorf("Range visited %d keys after stopping at 10", visited)
+	}
+
+	// fn may modify the table without deadlocking
+	ht.Range(func(k, _ int) bool {
+		ht.Delete(k)
+		return true
+	})
+	if ht.Len() != 0 {
+		t.Errorf("Len() after deleting during Range = %d, want 0", ht.Len())
+	}
+}
+
+// TestConcurrentHashTableStress mixes readers, writers, counters and Range calls on one table
+// Run it with -race to check that every operation is properly synchronized
+func TestConcurrentHashTableStress(t *testing.T) {
+	const (
+		workers = 8
+		keys    = 2000
+		rounds  = 3
+	)
+	ht := NewConcurrent[string, int](WithShards(4)) // Few shards, so goroutines contend for them
+
+	var wg sync.WaitGroup
+
+	// Writers: each owns a disjoint key range that it fills, partly deletes and fills again
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for r := 0; r < rounds; r++ {
+				for i := 0; i < keys; i++ {
+					ht.Put(fmt.Sprintf("w%d-%d", w, i), i)
+				}
+				for i := 0; i < keys; i += 2 {
+					if _, ok := ht.Delete(fmt.Sprintf("w%d-%d", w, i)); !ok {
+						t.Errorf("Delete(w%d-%d) = false, want true", w, i)
+					}
+				}
+			}
+		}()
+	}
+
+	// Counters: every worker increments the same shared keys
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < keys; i++ {
+				ht.Update(fmt.Sprintf("counter-%d", i%10), func(n int) int { return n + 1 })
+			}
+		}()
+	}
+
+	// Readers: look up keys that may or may not exist, and values must never be torn
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < keys*rounds; i++ {
+				key := fmt.Sprintf("w%d-%d", (w+1)%workers, i%keys)
+				if v, ok := ht.Get(key); ok && v != i%keys {
+					t.Errorf("Get(%q) = %d, want %d", key, v, i%keys)
+				}
+				ht.Search(key)
+			}
+		}()
+	}
+
+	// Rangers and Len callers walk the table while it changes
+	for w := 0; w < 2; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for r := 0; r < rounds; r++ {
+				ht.Range(func(string, int) bool { return true })
+				ht.Len()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	// Each writer leaves its odd keys behind, and no counter increment was lost
+	if want := workers*keys/2 + 10; ht.Len() != want {
+		t.Errorf("Len() = %d, want %d", ht.Len(), want)
+	}
+	for i := 0; i < 10; i++ {
+		if got, _ := ht.Get(fmt.Sprintf("counter-%d", i)); got != workers*keys/10 {
+			t.Errorf("counter-%d = %d, want %d", i, got, workers*keys/10)
+		}
+	}
+}
+
+// BenchmarkConcurrentHashTable measures a read-heavy mix (90% Get, 10% Put) from parallel goroutines
+// A single shard behaves like one table behind one RWMutex, which shows what sharding buys
+func BenchmarkConcurrentHashTable(b *testing.B) {
+	const n = 100_000
+	keys := benchmarkKeys(n)
+
+	for _, shards := range []int{1, 8, DefaultShards, 128} {
+		b.Run(fmt.Sprintf("shards=%d", shards), func(b *testing.B) {
+			ht := NewConcurrent[string, int](WithShards(shards), WithCapacity(n))
+			for i, key := range keys {
+				ht.Put(key, i)
+			}
+
+			b.RunParallel(func(pb *testing.PB) {
+				i := rand.IntN(n) // Each goroutine starts at a different key
+				for pb.Next() {
+					key := keys[i%n]
+					if i%10 == 0 {
+						ht.Put(key, i)
+					} else {
+						ht.Get(key)
+					}
+					i += 7919 // A prime stride, so consecutive operations hit different shards
+				}
+			})
+		})
+	}
+}
+
+func TestConcurrentHashTableStats(t *testing.T) {
+	ht := NewConcurrent[int, int](WithShards(4))
+	const n = 1000
+	for i := 0; i < n; i++ {
+		ht.Put(i, i)
+	}
+
+	got := ht.Stats()
+	wantBuckets := 0
+	for i := range ht.shards {
+		wantBuckets += ht.shards[i].table.Stats().Buckets
+	}
+	if got.Keys != n || got.Buckets != wantBuckets {
+		t.Errorf("Stats() = %d keys in %d buckets, want %d keys in %d buckets", got.Keys, got.Buckets, n, wantBuckets)
+	}
+
+	// The merged histogram accounts for every bucket and every key
+	buckets, keys := 0, 0
+	for length, count := range got.ChainLengths {
+		buckets += count
+		keys += length * count
+	}
+	if buckets != got.Buckets || keys != n {
+		t.Errorf("Chain lengths cover %d buckets and %d keys, want %d and %d", buckets, keys, got.Buckets, n)
+	}
+	if got.LongestChain != len(got.ChainLengths)-1 {
+		t.Errorf("LongestChain = %d, but the histogram goes up to %d", got.LongestChain, len(got.ChainLengths)-1)
+	}
+
+	// LoadFactor means the same as for a HashTable, even in the middle of a rehash: old
+	// buckets count in Buckets but not in LoadFactor
+	single := NewConcurrent[int, int](WithShards(1))
+	for i := 0; single.shards[0].table.old == nil; i++ {
+		single.Put(i, i)
+	}
+	got, want := single.Stats(), single.shards[0].table.Stats()
+	if got.LoadFactor != want.LoadFactor || got.Buckets != want.Buckets {
+		t.Errorf("Stats() during a rehash = load factor %v over %d buckets, want %v over %d",
+			got.LoadFactor, got.Buckets, want.LoadFactor, want.Buckets)
+	}
+	if got.LoadFactor == float64(got.Keys)/float64(got.Buckets) {
+		t.Errorf("LoadFactor = %v counts the old buckets of the rehash", got.LoadFactor)
+	}
+}
@@ -0,0 +1,154 @@
+package hashtable
+
+import (
+	"hash/maphash"
+	"math/bits"
+)
+
+// Hasher computes the hash value of a key
+// A HashTable reduces the hash modulo its number of buckets, so a good Hasher
+// spreads its output over all 64 bits, not just the low ones
+type Hasher[K comparable] interface {
+	Hash(key K) uint64
+}
+
+// seed is the hash seed shared by the default MapHash hasher of every table in this process
+// It is chosen randomly at startup, so bucket positions differ between runs
+var seed = maphash.MakeSeed()
+
+// MapHash hashes any comparable key with hash/maphash
+// It is the default Hasher: it works for every key type and its random seed
+// makes the bucket of a key unpredictable, which protects against collision attacks
+type MapHash[K comparable] struct {
+	seed maphash.Seed
+}
+
+// NewMapHash creates a MapHash with its own random seed
+func NewMapHash[K comparable]() MapHash[K] {
+	return MapHash[K]{seed: maphash.MakeSeed()}
+}
+
+// Hash returns the maphash of the key
+// The zero MapHash uses the seed shared by all default tables
+// Time complexity: O(len(key))
+func (h MapHash[K]) Hash(key K) uint64 {
+	if h.seed == (maphash.Seed{}) {
+		return maphash.Comparable(seed, key)
+	}
+	return maphash.Comparable(h.seed, key)
+}
+
+// FNV-1a 64-bit parameters
+const (
+	fnvOffset64 = 14695981039346656037
+	fnvPrime64  = 1099511628211
+)
+
+// FNV1a hashes string keys with the 64-bit FNV-1a algorithm
+// It is unseeded, so a key always lands in the same bucket, which makes it easy to reason about
+type FNV1a[K ~string] struct{}
+
+// Hash returns the FNV-1a hash of the key
+// Time complexity: O(len(key))
+func (FNV1a[K]) Hash(key K) uint64 {
+	h := uint64(fnvOffset64)
+	for i := 0; i < len(key); i++ {
+		h ^= uint64(key[i])
+		h *= fnvPrime64
+	}
+	return h
+}
+
+// XXH64 primes
+const (
+	xxPrime1 uint64 = 11400714785074694791
+	xxPrime2 uint64 = 14029467366897019727
+	xxPrime3 uint64 = 1609587929392839161
+	xxPrime4 uint64 = 9650029242287828579
+	xxPrime5 uint64 = 2870177450012600261
+)
+
+// XXHash hashes string keys with the 64-bit xxHash algorithm (XXH64)
+// It consumes 8 bytes per step instead of one, so it is faster than FNV1a on long keys
+type XXHash[K ~string] struct {
+	Seed uint64 // Seed mixed into every hash; the zero value gives the reference XXH64 output
+}
+
+// Hash returns the XXH64 hash of the key
+// Time complexity: O(len(key))
+func (x XXHash[K]) Hash(key K) uint64 {
+	s := string(key)
+	n := len(s)
+
+	var h uint64
+	if n >= 32 {
+		// Four independent lanes over 32-byte stripes
+		v1 := x.Seed + xxPrime1 + xxPrime2
+		v2 := x.Seed + xxPrime2
+		v3 := x.Seed
+		v4 := x.Seed - xxPrime1
+		for ; len(s) >= 32; s = s[32:] {
+			v1 = xxRound(v1, readUint64(s[0:8]))
+			v2 = xxRound(v2, readUint64(s[8:16]))
+			v3 = xxRound(v3, readUint64(s[16:24]))
+			v4 = xxRound(v4, readUint64(s[24:32]))
+		}
+		h = bits.RotateLeft64(v1, 1) + bits.RotateLeft64(v2, 7) +
+			bits.RotateLeft64(v3, 12) + bits.RotateLeft64(v4, 18)
+		h = xxMergeRound(h, v1)
+		h = xxMergeRound(h, v2)
+		h = xxMergeRound(h, v3)
+		h = xxMergeRound(h, v4)
+	} else {
+		h = x.Seed + xxPrime5
+	}
+	h += uint64(n)
+
+	// Remaining bytes: 8 at a time, then 4, then one by one
+	for ; len(s) >= 8; s = s[8:] {
+		h ^= xxRound(0, readUint64(s))
+		h = bits.RotateLeft64(h, 27)*xxPrime1 + xxPrime4
+	}
+	if len(s) >= 4 {
+		h ^= uint64(readUint32(s)) * xxPrime1
+		h = bits.RotateLeft64(h, 23)*xxPrime2 + xxPrime3
+		s = s[4:]
+	}
+	for ; len(s) > 0; s = s[1:] {
+		h ^= uint64(s[0]) * xxPrime5
+		h = bits.RotateLeft64(h, 11) * xxPrime1
+	}
+
+	// Final avalanche so every input bit affects every output bit
+	h ^= h >> 33
+	h *= xxPrime2
+	h ^= h >> 29
+	h *= xxPrime3
+	h ^= h >> 32
+	return h
+}
+
+// xxRound mixes 8 bytes of input into an accumulator lane
+func xxRound(acc, input uint64) uint64 {
+	acc += input * xxPrime2
+	return bits.RotateLeft64(acc, 31) * xxPrime1
+}
+
+// xxMergeRound folds a lane into the combined hash
+func xxMergeRound(acc, val uint64) uint64 {
+	acc ^= xxRound(0, val)
+	return acc*xxPrime1 + xxPrime4
+}
+
+// readUint64 reads the first 8 bytes of s as a little-endian integer without copying
+func readUint64(s string) uint64 {
+	_ = s[7] // Bounds check hint
+	return uint64(s[0]) | uint64(s[1])<<8 | uint64(s[2])<<16 | uint64(s[3])<<24 |
+		uint64(s[4])<<32 | uint64(s[5])<<40 | uint64(s[6])<<48 | uint64(s[7])<<56
+}
+
+// readUint32 reads the first 4 bytes of s as a little-endian integer without copying
+func readUint32(s string) uint32 {
+	_ = s[3] // Bounds check hint
+	return uint32(s[0]) | uint32(s[1])<<8 | uint32(s[2])<<16 | uint32(s[3])<<24
+}
@@ -0,0 +1,151 @@
+package hashtable
+
+import (
+	"fmt"
+	"hash/fnv"
+	"testing"
+)
+
+// testHashers are the built-in hashers exercised by the table-driven tests below
+var testHashers = []struct {
+	name   string
+	hasher Hasher[string]
+}{
+	{name: "MapHash", hasher: MapHash[string]{}},
+	{name: "SeededMapHash", hasher: NewMapHash[string]()},
+	{name: "FNV1a", hasher: FNV1a[string]{}},
+	{name: "XXHash", hasher: XXHash[string]{}},
+	{name: "SeededXXHash", hasher: XXHash[string]{Seed: 42}},
+}
+
+func TestFNV1a(t *testing.T) {
+	keys := []string{"", "a", "listen", "silent", "The quick brown fox jumps over the lazy dog"}
+
+	for _, key := range keys {
+		// The standard library implementation is the reference
+		ref := fnv.New64a()
+		ref.Write([]byte(key))
+		if got, want := (FNV1a[string]{}).Hash(key), ref.Sum64(); got != want {
+			t.Errorf("FNV1a.Hash(%q) = %#x, want %#x", key, got, want)
+		}
+	}
+}
+
+func TestXXHash(t *testing.T) {
+	// Reference XXH64 outputs with seed 0, covering the short path and the 32-byte stripe path
+	tests := []struct {
+		key  string
+		want uint64
+	}{
+		{key: "", want: 0xef46db3751d8e999},
+		{key: "a", want: 0xd24ec4f1a98c6e5b},
+		{key: "abc", want: 0x44bc2cf5ad770999},
+		{key: "Nobody inspects the spammish repetition", want: 0xfbcea83c8a378bf1},
+	}
+
+	for _, tt := range tests {
+		if got := (XXHash[string]{}).Hash(tt.key); got != tt.want {
+			t.Errorf("XXHash.Hash(%q) = %#x, want %#x", tt.key, got, tt.want)
+		}
+	}
+
+	if (XXHash[string]{Seed: 1}).Hash("abc") == (XXHash[string]{}).Hash("abc") {
+		t.Errorf("XXHash with a different seed returned the same hash")
+	}
+}
+
+func TestMapHash(t *testing.T) {
+	// The zero value uses the shared seed, so GetHashValue agrees with default tables
+	if got, want := int(MapHash[string]{}.Hash("ERIC")%ArraySize), GetHashValue("ERIC"); got != want {
+		t.Errorf("MapHash bucket of %q = %d, want %d", "ERIC", got, want)
+	}
+
+	a, b := NewMapHash[string](), NewMapHash[string]()
+	if a.Hash("ERIC") != a.Hash("ERIC") {
+		t.Errorf("MapHash is not deterministic for the same seed")
+	}
+	if a.Hash("ERIC") == b.Hash("ERIC") {
+		t.Errorf("MapHash with different seeds returned the same hash")
+	}
+}
+
+func TestHashersAnagrams(t *testing.T) {
+	for _, tt := range testHashers {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.hasher.Hash("listen") == tt.hasher.Hash("silent") {
+				t.Errorf("%s hashes the anagrams %q and %q to the same value", tt.name, "listen", "silent")
+			}
+		})
+	}
+}
+
+func TestHasherDistribution(t *testing.T) {
+	const (
+		numKeys    = 10_000
+		numBuckets = 1024
+	)
+	keys := benchmarkKeys(numKeys)
+
+	for _, tt := range testHashers {
+		t.Run(tt.name, func(t *testing.T) {
+			counts := make([]int, numBuckets)
+			for _, key := range keys {
+				counts[tt.hasher.Hash(key)%numBuckets]++
+			}
+
+			// About 10 keys per bucket on average; a well-mixed hash keeps every bucket well below 3x that
+			longest := 0
+			for _, c := range counts {
+				longest = max(longest, c)
+			}
+			if longest > 3*numKeys/numBuckets {
+				t.Errorf("%s: longest bucket holds %d keys, want at most %d", tt.name, longest, 3*numKeys/numBuckets)
+			}
+		})
+	}
+}
+
+func TestNewWithHasher(t *testing.T) {
+	keys := benchmarkKeys(1000)
+
+	for _, tt := range testHashers {
+		t.Run(tt.name, func(t *testing.T) {
+			ht := NewWithHasher[string, int](tt.hasher)
+			for i, key := range keys {
+				ht.Put(key, i)
+			}
+
+			for i, key := range keys {
+				if got, ok := ht.Get(key); !ok || got != i {
+					t.Fatalf("Get(%q) = (%d, %v), want (%d, true)", key, got, ok, i)
+				}
+			}
+			for _, key := range keys[:500] {
+				if _, ok := ht.Delete(key); !ok {
+					t.Fatalf("Delete(%q) = false, want true", key)
+				}
+			}
+			if ht.Len() != 500 {
+				t.Errorf("Len() = %d, want 500", ht.Len())
+			}
+			if ht.Search(keys[0]) || !ht.Search(keys[999]) {
+				t.Errorf("Search after Delete returned the wrong result")
+			}
+		})
+	}
+}
+
+// BenchmarkHashers measures the cost of hashing keys of different lengths with each built-in hasher
+func BenchmarkHashers(b *testing.B) {
+	for _, length := range []int{8, 64, 1024} {
+		key := fmt.Sprintf("%0*d", length, 0)
+		for _, tt := range testHashers {
+			b.Run(fmt.Sprintf("%s/len=%d", tt.name, length), func(b *testing.B) {
+				b.SetBytes(int64(length))
+				for b.Loop() {
+					tt.hasher.Hash(key)
+				}
+			})
+		}
+	}
+}
@@ -1,78 +1,478 @@
 package hashtable
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"math"
+)
 
-// ArraySize is the size of the hash table array
+// ArraySize is the default (and minimum) number of buckets in a new hash table
 const ArraySize = 7
 
-// HashTable represents the hash table data structure
-type HashTable struct {
-	array [ArraySize]*bucket
+// ErrKeyExists is returned by Insert when the key is already in the table
+var ErrKeyExists = errors.New("key already exists")
+
+// Default load-factor thresholds
+// The load factor is the number of keys divided by the number of buckets
+const (
+	DefaultGrowLoadFactor   = 0.75  // Double the bucket array once the load factor exceeds this
+	DefaultShrinkLoadFactor = 0.125 // Halve the bucket array once the load factor drops below this
+)
+
+// rehashBatch is the smallest number of old buckets migrated by every Insert or Delete while
+// rehashing. Each rehash raises its batch so that it finishes before the next resize can be due
+// (see startRehash): with the default load factors a grow keeps two buckets per operation, but
+// after a shrink the old array is twice the size of the new one and the next shrink can come
+// after only 1/8 as many deletes as there are new buckets, so it migrates 16
+const rehashBatch = 2
+
+// HashTable represents the hash table data structure mapping keys of type K to values of type V
+// The bucket array grows and shrinks with the number of keys. Resizing is incremental:
+// while a rehash is in progress both the old and the new bucket arrays are live, and every
+// Insert or Delete moves a few old buckets across, so no single call pays for the whole rehash
+type HashTable[K comparable, V any] struct {
+	buckets   []bucket[K, V] // Current bucket array
+	old       []bucket[K, V] // Bucket array being migrated away from, nil when no rehash is in progress
+	rehashIdx int            // Index of the next bucket in old to migrate
+	batch     int            // Number of old buckets migrated per operation by the current rehash
+	count     int            // Number of keys stored
+	hasher    Hasher[K]      // Hash function chosen at construction
+
+	minBuckets       int     // The bucket array never shrinks below this size
+	growLoadFactor   float64 // Load factor above which the bucket array doubles
+	shrinkLoadFactor float64 // Load factor below which the bucket array halves
+}
+
+// StringSet is a HashTable used as a set of strings, with no value attached to each key
+// It keeps the original string-only API available through InitHashTable
+type StringSet = HashTable[string, struct{}]
+
+// Table is the API shared by the chained HashTable and the open-addressing OpenHashTable,
+// so callers can switch backends without other changes
+type Table[K comparable, V any] interface {
+	Insert(key K) error
+	Upsert(key K, value V) bool
+	Put(key K, value V)
+	Search(key K) bool
+	Get(key K) (V, bool)
+	GetOrDefault(key K, def V) V
+	Update(key K, fn func(V) V) V
+	Delete(key K) (V, bool)
+	Len() int
+	Keys() []K
+	All() iter.Seq2[K, V]
+	Clear()
 }
 
+// Both backends implement Table
+var (
+	_ Table[string, int] = (*HashTable[string, int])(nil)
+	_ Table[string, int] = (*OpenHashTable[string, int])(nil)
+)
+
 // bucket represents a linked list in each array position
-type bucket struct {
-	head *bucketNode
+type bucket[K comparable, V any] struct {
+	head *bucketNode[K, V]
+}
+
+// bucketNode represents a linked list node that holds a key and its value
+type bucketNode[K comparable, V any] struct {
+	key   K
+	value V
+	next  *bucketNode[K, V]
+}
+
+// config holds the settings collected from Options before a HashTable is built
+type config struct {
+	capacity         int     // Number of keys the table should hold without resizing
+	growLoadFactor   float64 // Load factor above which the bucket array doubles
+	shrinkLoadFactor float64 // Load factor below which the bucket array halves
+	shards           int     // Number of independently locked shards of a ConcurrentHashTable
+}
+
+// Option configures a HashTable created by New
+type Option func(*config)
+
+// WithCapacity sizes the initial bucket array so that n keys fit without resizing
+// The bucket array never shrinks below this initial size
+func WithCapacity(n int) Option {
+	return func(c *config) {
+		c.capacity = n
+	}
+}
+
+// WithLoadFactors sets the thresholds that trigger resizing
+// The table halves when the load factor drops below shrink and doubles when it exceeds grow
+// shrink must be less than half of grow so that a resize never immediately triggers the opposite one;
+// a shrink of 0 disables shrinking
+func WithLoadFactors(shrink, grow float64) Option {
+	return func(c *config) {
+		c.shrinkLoadFactor = shrink
+		c.growLoadFactor = grow
+	}
+}
+
+// WithShards sets the number of independently locked shards of a ConcurrentHashTable,
+// rounded up to a power of two. More shards let more writers proceed in parallel
+// It has no effect on HashTable and OpenHashTable
+func WithShards(n int) Option {
+	return func(c *config) {
+		c.shards = n
+	}
+}
+
+// buildConfig applies the options on top of the defaults
+// It panics if the load-factor thresholds are invalid
+func buildConfig(opts []Option) config {
+	c := config{
+		growLoadFactor:   DefaultGrowLoadFactor,
+		shrinkLoadFactor: DefaultShrinkLoadFactor,
+	}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	if c.growLoadFactor <= 0 || c.shrinkLoadFactor < 0 || c.shrinkLoadFactor*2 >= c.growLoadFactor {
+		panic(fmt.Sprintf("hashtable: invalid load factors (shrink %v, grow %v)", c.shrinkLoadFactor, c.growLoadFactor))
+	}
+	return c
+}
+
+// New creates a new HashTable configured by the given options, hashing keys with MapHash
+// It panics if the load-factor thresholds are invalid
+func New[K comparable, V any](opts ...Option) *HashTable[K, V] {
+	return NewWithHasher[K, V](MapHash[K]{}, opts...)
 }
 
-// bucketNode represents a linked list node that holds the key
-type bucketNode struct {
-	key  string
-	next *bucketNode
+// NewWithHasher creates a new HashTable that hashes keys with h
+// It panics if the load-factor thresholds are invalid
+// Parameters:
+//   - h: The hash function, e.g. FNV1a[string]{}, XXHash[string]{} or NewMapHash[string]()
+//   - opts: Options such as WithCapacity and WithLoadFactors
+func NewWithHasher[K comparable, V any](h Hasher[K], opts ...Option) *HashTable[K, V] {
+	c := buildConfig(opts)
+	minBuckets := max(ArraySize, int(float64(c.capacity)/c.growLoadFactor)+1)
+	return &HashTable[K, V]{
+		buckets:          make([]bucket[K, V], minBuckets),
+		hasher:           h,
+		minBuckets:       minBuckets,
+		growLoadFactor:   c.growLoadFactor,
+		shrinkLoadFactor: c.shrinkLoadFactor,
+	}
+}
+
+// InitHashTable creates and initializes a new set of strings with the default configuration
+func InitHashTable() *StringSet {
+	return New[string, struct{}]()
+}
+
+// Insert adds a key to the hash table with the zero value, if it is not already present
+// This is the set-style counterpart of Put, which also stores a value
+// Time complexity: O(1) on average, including the share of an in-progress rehash
+// Returns:
+//   - error: nil if the key was added, or an error wrapping ErrKeyExists if it was already present
+func (ht *HashTable[K, V]) Insert(key K) error {
+	ht.rehashStep()
+	b := ht.bucketFor(key)
+	if b.find(key) != nil {
+		return fmt.Errorf("insert %v: %w", key, ErrKeyExists)
+	}
+	var zero V
+	b.insert(key, zero)
+	ht.count++
+	ht.maybeResize()
+	return nil
+}
+
+// Upsert stores the value for the key, replacing any existing value
+// Time complexity: O(1) on average, including the share of an in-progress rehash
+// Returns:
+//   - bool: True if the key was newly inserted, false if an existing value was replaced
+func (ht *HashTable[K, V]) Upsert(key K, value V) bool {
+	ht.rehashStep()
+	b := ht.bucketFor(key)
+	if node := b.find(key); node != nil {
+		node.value = value
+		return false
+	}
+	b.insert(key, value)
+	ht.count++
+	ht.maybeResize()
+	return true
 }
 
-// Insert adds a key to the hash table
-func (ht *HashTable) Insert(key string) {
-	index := hash(key)
-	ht.array[index].insert(key)
+// Put stores the value for the key, replacing any existing value
+// It is Upsert for callers that do not need to know whether the key was new
+// Time complexity: O(1) on average, including the share of an in-progress rehash
+func (ht *HashTable[K, V]) Put(key K, value V) {
+	ht.Upsert(key, value)
 }
 
 // Search returns true if the key exists in the hash table
-func (ht *HashTable) Search(key string) bool {
-	index := hash(key)
-	return ht.array[index].search(key)
+// Time complexity: O(1) on average
+func (ht *HashTable[K, V]) Search(key K) bool {
+	return ht.bucketFor(key).find(key) != nil
 }
 
-// Delete removes a key from the hash table and returns true if the key was found and deleted
-func (ht *HashTable) Delete(key string) bool {
-	index := hash(key)
-	return ht.array[index].delete(key)
+// Get returns the value stored for the key
+// Time complexity: O(1) on average
+// Returns:
+//   - V: The stored value, or the zero value if the key is absent
+//   - bool: True if the key was found
+func (ht *HashTable[K, V]) Get(key K) (V, bool) {
+	if node := ht.bucketFor(key).find(key); node != nil {
+		return node.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// GetOrDefault returns the value stored for the key, or def if the key is absent
+// Time complexity: O(1) on average
+func (ht *HashTable[K, V]) GetOrDefault(key K, def V) V {
+	if value, ok := ht.Get(key); ok {
+		return value
+	}
+	return def
 }
 
-// insert adds a key to a bucket
-func (b *bucket) insert(k string) {
-	if !b.search(k) {
-		newNode := &bucketNode{key: k}
-		newNode.next = b.head
-		b.head = newNode
-	} else {
-		fmt.Println("Key already exists:", k)
+// Update replaces the value for the key with fn applied to it and returns the new value
+// If the key is absent, fn receives the zero value and the result is inserted,
+// which makes counters a one-liner: ht.Update(word, func(n int) int { return n + 1 })
+// Time complexity: O(1) on average, plus the cost of fn
+func (ht *HashTable[K, V]) Update(key K, fn func(V) V) V {
+	ht.rehashStep()
+	b := ht.bucketFor(key)
+	if node := b.find(key); node != nil {
+		node.value = fn(node.value)
+		return node.value
 	}
+
+	var zero V
+	value := fn(zero)
+	b.insert(key, value)
+	ht.count++
+	ht.maybeResize()
+	return value
+}
+
+// Delete removes a key from the hash table
+// Time complexity: O(1) on average, including the share of an in-progress rehash
+// Returns:
+//   - V: The value that was stored for the key, or the zero value if the key was absent
+//   - bool: True if the key was found and deleted
+func (ht *HashTable[K, V]) Delete(key K) (V, bool) {
+	ht.rehashStep()
+	value, ok := ht.bucketFor(key).delete(key)
+	if !ok {
+		return value, false
+	}
+	ht.count--
+	ht.maybeResize()
+	return value, true
+}
+
+// Len returns the number of keys stored in the hash table
+// Time complexity: O(1)
+func (ht *HashTable[K, V]) Len() int {
+	return ht.count
+}
+
+// Keys returns the keys stored in the hash table, in no particular order
+// Time complexity: O(n + b) where n is the number of keys and b the number of buckets
+func (ht *HashTable[K, V]) Keys() []K {
+	keys := make([]K, 0, ht.count)
+	ht.forEach(func(k K, _ V) bool {
+		keys = append(keys, k)
+		return true
+	})
+	return keys
 }
 
-// search looks for a key in a bucket and returns true if found
-func (b *bucket) search(k string) bool {
+// All returns an iterator over the keys and values in the hash table, in no particular order
+// The table must not be modified while iterating
+// Time complexity: O(n + b) for a full iteration, where b is the number of buckets
+func (ht *HashTable[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		ht.forEach(yield)
+	}
+}
+
+// Clear removes every key and returns the bucket array to its initial size
+// Time complexity: O(1), plus the allocation of the new bucket array
+func (ht *HashTable[K, V]) Clear() {
+	ht.buckets = make([]bucket[K, V], ht.minBuckets)
+	ht.old = nil
+	ht.rehashIdx = 0
+	ht.batch = 0
+	ht.count = 0
+}
+
+// Stats describes how the keys of a HashTable are spread over its buckets
+type Stats struct {
+	Keys         int     // Number of keys stored
+	Buckets      int     // Number of buckets walked, empty ones included: the current array plus old buckets still waiting to be rehashed
+	LoadFactor   float64 // Keys per bucket of the current bucket array, the value compared with the resize thresholds
+	LongestChain int     // Number of keys in the fullest bucket
+	ChainLengths []int   // ChainLengths[n] is the number of buckets holding exactly n keys
+	Rehashing    bool    // True while an incremental rehash is in progress
+}
+
+// Stats walks every bucket and reports the distribution of keys
+// With a good hash function most buckets hold 0, 1 or 2 keys and the longest chain stays short
+// Time complexity: O(n + b) where n is the number of keys and b the number of buckets
+func (ht *HashTable[K, V]) Stats() Stats {
+	s := Stats{
+		Keys:       ht.count,
+		LoadFactor: float64(ht.count) / float64(len(ht.buckets)),
+		Rehashing:  ht.old != nil,
+	}
+
+	// Buckets of the old array before rehashIdx have already been migrated
+	live := [][]bucket[K, V]{ht.buckets}
+	if ht.old != nil {
+		live = append(live, ht.old[ht.rehashIdx:])
+	}
+	for _, buckets := range live {
+		for i := range buckets {
+			n := 0
+			for node := buckets[i].head; node != nil; node = node.next {
+				n++
+			}
+			for len(s.ChainLengths) <= n {
+				s.ChainLengths = append(s.ChainLengths, 0)
+			}
+			s.ChainLengths[n]++
+			s.LongestChain = max(s.LongestChain, n)
+		}
+		s.Buckets += len(buckets)
+	}
+	return s
+}
+
+// forEach calls fn for every key and value in the table, in no particular order, until fn returns false
+// The table must not be modified during the walk
+// Returns false if fn stopped the walk
+func (ht *HashTable[K, V]) forEach(fn func(K, V) bool) bool {
+	for _, buckets := range [][]bucket[K, V]{ht.old, ht.buckets} {
+		for i := range buckets {
+			for node := buckets[i].head; node != nil; node = node.next {
+				if !fn(node.key, node.value) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// bucketFor returns the bucket that holds, or would hold, the key
+// During a rehash a key lives in the old array until its bucket has been migrated,
+// so every key has exactly one home bucket at any time
+func (ht *HashTable[K, V]) bucketFor(key K) *bucket[K, V] {
+	h := ht.hasher.Hash(key)
+	if ht.old != nil {
+		if i := int(h % uint64(len(ht.old))); i >= ht.rehashIdx {
+			return &ht.old[i]
+		}
+	}
+	return &ht.buckets[h%uint64(len(ht.buckets))]
+}
+
+// maybeResize starts a rehash if the load factor has crossed one of the thresholds
+func (ht *HashTable[K, V]) maybeResize() {
+	size := len(ht.buckets)
+	load := float64(ht.count) / float64(size)
+
+	switch {
+	case load > ht.growLoadFactor:
+		ht.startRehash(size * 2)
+	case load < ht.shrinkLoadFactor && size > ht.minBuckets:
+		ht.startRehash(max(size/2, ht.minBuckets))
+	}
+}
+
+// startRehash allocates a new bucket array of the given size and begins migrating into it
+// The batch is sized so the migration is done by the time the next resize can be due; if a
+// previous rehash is still running anyway, it is finished first
+func (ht *HashTable[K, V]) startRehash(size int) {
+	for ht.old != nil {
+		ht.rehashStep()
+	}
+	ht.old = ht.buckets
+	ht.buckets = make([]bucket[K, V], size)
+	ht.rehashIdx = 0
+
+	// Count the operations, including the one that triggers it, before the load factor can
+	// cross a threshold of the new array; every one of them migrates a batch
+	ops := int(math.Floor(ht.growLoadFactor*float64(size))) + 1 - ht.count
+	if size > ht.minBuckets {
+		ops = min(ops, ht.count-int(math.Ceil(ht.shrinkLoadFactor*float64(size)))+1)
+	}
+	ops = max(ops, 1)
+	ht.batch = max(rehashBatch, (len(ht.old)+ops-1)/ops)
+}
+
+// rehashStep migrates up to batch buckets from the old array into the current one
+func (ht *HashTable[K, V]) rehashStep() {
+	if ht.old == nil {
+		return
+	}
+
+	for n := 0; n < ht.batch && ht.rehashIdx < len(ht.old); n++ {
+		// Relink every node of the old bucket into its new home; no allocation is needed
+		node := ht.old[ht.rehashIdx].head
+		for node != nil {
+			next := node.next
+			b := &ht.buckets[ht.hasher.Hash(node.key)%uint64(len(ht.buckets))]
+			node.next = b.head
+			b.head = node
+			node = next
+		}
+		ht.old[ht.rehashIdx].head = nil
+		ht.rehashIdx++
+	}
+
+	if ht.rehashIdx == len(ht.old) {
+		ht.old = nil // Migration finished; release the old array
+		ht.rehashIdx = 0
+	}
+}
+
+// insert adds a key and value at the front of a bucket
+// The caller must make sure the key is not already present
+func (b *bucket[K, V]) insert(k K, v V) {
+	newNode := &bucketNode[K, V]{key: k, value: v}
+	newNode.next = b.head
+	b.head = newNode
+}
+
+// find looks for a key in a bucket and returns its node, or nil if not found
+func (b *bucket[K, V]) find(k K) *bucketNode[K, V] {
 	currentNode := b.head
 	for currentNode != nil {
 		if currentNode.key == k {
-			return true
+			return currentNode
 		}
 		currentNode = currentNode.next
 	}
-	return false
+	return nil
 }
 
-// delete removes a key from a bucket and returns true if the key was found and deleted
-func (b *bucket) delete(k string) bool {
+// delete removes a key from a bucket and returns its value and true if the key was found and deleted
+func (b *bucket[K, V]) delete(k K) (V, bool) {
+	var zero V
+
 	// If bucket is empty
 	if b.head == nil {
-		return false
+		return zero, false
 	}
 
 	// If head is the key to delete
 	if b.head.key == k {
+		value := b.head.value
 		b.head = b.head.next
-		return true
+		return value, true
 	}
 
 	// Find the key in the bucket
@@ -80,40 +480,20 @@ func (b *bucket) delete(k string) bool {
 	for previousNode.next != nil {
 		if previousNode.next.key == k {
 			// Delete by updating the pointer
+			value := previousNode.next.value
 			previousNode.next = previousNode.next.next
-			return true
+			return value, true
 		}
 		previousNode = previousNode.next
 	}
 
 	// Key was not found
-	return false
-}
-
-// hash creates a hash value from the key
-func hash(key string) int {
-	sum := 0
-	for _, char := range key {
-		sum += int(char)
-	}
-	return sum % ArraySize
-}
-
-// InitHashTable creates and initializes a new HashTable
-func InitHashTable() *HashTable {
-	result := &HashTable{}
-	for i := range result.array {
-		result.array[i] = &bucket{}
-	}
-	return result
+	return zero, false
 }
 
-// GetHashValue returns the hash value for a given key without applying modulo
+// GetHashValue returns the bucket index a string key maps to in a table created by New
+// or InitHashTable, before any resize (ArraySize buckets)
 // This is useful for demonstration purposes to understand how the hash function works
 func GetHashValue(key string) int {
-	sum := 0
-	for _, char := range key {
-		sum += int(char)
-	}
-	return sum % ArraySize
+	return int(MapHash[string]{}.Hash(key) % ArraySize)
 }
@@ -1,6 +1,12 @@
 package hashtable
 
 import (
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
 	"strings"
 	"testing"
 )
@@ -29,7 +35,7 @@ func TestHashTableDelete(t *testing.T) {
 	ht.Insert("KENNY")
 
 	// Delete a key and verify it returns true for successful deletion
-	if deleted := ht.Delete("ERIC"); !deleted {
+	if _, deleted := ht.Delete("ERIC"); !deleted {
 		t.Errorf("Delete operation should return true when key 'ERIC' is deleted")
 	}
 
@@ -42,12 +48,12 @@ func TestHashTableDelete(t *testing.T) {
 	}
 
 	// Try to delete a non-existent key and verify it returns false
-	if deleted := ht.Delete("NONEXISTENT"); deleted {
+	if _, deleted := ht.Delete("NONEXISTENT"); deleted {
 		t.Errorf("Delete operation should return false when key 'NONEXISTENT' doesn't exist")
 	}
 
 	// Deleting an already deleted key should return false
-	if deleted := ht.Delete("ERIC"); deleted {
+	if _, deleted := ht.Delete("ERIC"); deleted {
 		t.Errorf("Delete operation should return false when key 'ERIC' is already deleted")
 	}
 }
@@ -82,14 +88,8 @@ func TestHashTableSearch(t *testing.T) {
 func TestHashCollisions(t *testing.T) {
 	ht := InitHashTable()
 
-	// These two keys should hash to the same value due to our simple hash function
-	key1 := "abc"
-	key2 := "cba"
-
-	// Verify they have the same hash
-	if hash(key1) != hash(key2) {
-		t.Skip("This test assumes keys hash to the same value, but they don't with the current hash function")
-	}
+	// These two keys map to the same bucket in a newly created table
+	key1, key2 := collidingKeys()
 
 	// Insert both keys
 	ht.Insert(key1)
@@ -104,7 +104,7 @@ func TestHashCollisions(t *testing.T) {
 	}
 
 	// Delete the first key and check return value
-	if deleted := ht.Delete(key1); !deleted {
+	if _, deleted := ht.Delete(key1); !deleted {
 		t.Errorf("Delete operation should return true when key '%s' is deleted", key1)
 	}
 
@@ -179,7 +179,23 @@ func TestTableDrivenInsertAndSearch(t *testing.T) {
 	}
 }
 
+// collidingKeys returns two distinct keys that map to the same bucket in a newly created table
+// The hash seed is random per process, so the pair is searched for at runtime; with ArraySize
+// buckets, any ArraySize+1 keys are guaranteed to contain a colliding pair
+func collidingKeys() (string, string) {
+	seen := map[int]string{}
+	for i := 0; ; i++ {
+		key := fmt.Sprintf("key-%d", i)
+		if other, ok := seen[GetHashValue(key)]; ok {
+			return other, key
+		}
+		seen[GetHashValue(key)] = key
+	}
+}
+
 func TestTableDrivenDelete(t *testing.T) {
+	collide1, collide2 := collidingKeys()
+
 	// Table-driven test cases for Delete operation
 	tests := []struct {
 		name            string
@@ -219,12 +235,12 @@ func TestTableDrivenDelete(t *testing.T) {
 		},
 		{
 			name:            "Delete key with collision",
-			keysToInsert:    []string{"abc", "cba"}, // These should collide with our hash function
-			keyToDelete:     "abc",
+			keysToInsert:    []string{collide1, collide2}, // These map to the same bucket in a new table
+			keyToDelete:     collide1,
 			expectedDeleted: true,
 			expectedExists: map[string]bool{
-				"abc": false,
-				"cba": true,
+				collide1: false,
+				collide2: true,
 			},
 		},
 		{
@@ -251,7 +267,7 @@ func TestTableDrivenDelete(t *testing.T) {
 			}
 
 			// Perform the delete operation
-			deleted := ht.Delete(test.keyToDelete)
+			_, deleted := ht.Delete(test.keyToDelete)
 
 			// Check if the delete result matches the expected result
 			if deleted != test.expectedDeleted {
@@ -261,7 +277,7 @@ func TestTableDrivenDelete(t *testing.T) {
 
 			// For "Delete already deleted key" test, try deleting again and check it fails
 			if test.name == "Delete already deleted key" {
-				deleted = ht.Delete(test.keyToDelete)
+				_, deleted = ht.Delete(test.keyToDelete)
 				if deleted {
 					t.Errorf("Second delete for '%s' should return false, got true",
 						test.keyToDelete)
@@ -297,7 +313,7 @@ func TestHashCollisionHandling(t *testing.T) {
 
 		for i := 0; i < len(candidates); i++ {
 			for j := i + 1; j < len(candidates); j++ {
-				if hash(candidates[i]) == hash(candidates[j]) {
+				if GetHashValue(candidates[i]) == GetHashValue(candidates[j]) {
 					return candidates[i], candidates[j], true
 				}
 			}
@@ -311,22 +327,22 @@ func TestHashCollisionHandling(t *testing.T) {
 		t.Skip("Could not find any colliding keys for testing")
 	}
 
-	hashVal := hash(key1)
+	hashVal := GetHashValue(key1)
 	t.Logf("Found colliding keys '%s' and '%s' that hash to %d", key1, key2, hashVal)
 
 	tests := []struct {
 		name          string
-		setup         func(*HashTable)
-		action        func(*HashTable) bool
+		setup         func(*StringSet)
+		action        func(*StringSet) bool
 		expectedFound map[string]bool
 	}{
 		{
 			name: "Insert two colliding keys",
-			setup: func(ht *HashTable) {
+			setup: func(ht *StringSet) {
 				ht.Insert(key1)
 				ht.Insert(key2)
 			},
-			action: func(ht *HashTable) bool {
+			action: func(ht *StringSet) bool {
 				return true // No action, just setup
 			},
 			expectedFound: map[string]bool{
@@ -336,12 +352,13 @@ func TestHashCollisionHandling(t *testing.T) {
 		},
 		{
 			name: "Delete first colliding key",
-			setup: func(ht *HashTable) {
+			setup: func(ht *StringSet) {
 				ht.Insert(key1)
 				ht.Insert(key2)
 			},
-			action: func(ht *HashTable) bool {
-				return ht.Delete(key1)
+			action: func(ht *StringSet) bool {
+				_, deleted := ht.Delete(key1)
+				return deleted
 			},
 			expectedFound: map[string]bool{
 				key1: false,
@@ -350,12 +367,13 @@ func TestHashCollisionHandling(t *testing.T) {
 		},
 		{
 			name: "Delete second colliding key",
-			setup: func(ht *HashTable) {
+			setup: func(ht *StringSet) {
 				ht.Insert(key1)
 				ht.Insert(key2)
 			},
-			action: func(ht *HashTable) bool {
-				return ht.Delete(key2)
+			action: func(ht *StringSet) bool {
+				_, deleted := ht.Delete(key2)
+				return deleted
 			},
 			expectedFound: map[string]bool{
 				key1: true,
@@ -363,15 +381,16 @@ func TestHashCollisionHandling(t *testing.T) {
 			},
 		}, {
 			name: "Insert two colliding keys and verify independence",
-			setup: func(ht *HashTable) {
+			setup: func(ht *StringSet) {
 				// Just insert the two keys we already know collide
 				ht.Insert(key1)
 				ht.Insert(key2)
 				t.Logf("Inserted colliding keys '%s' and '%s'", key1, key2)
 			},
-			action: func(ht *HashTable) bool {
+			action: func(ht *StringSet) bool {
 				// Delete key2 and return the result
-				return ht.Delete(key2)
+				_, deleted := ht.Delete(key2)
+				return deleted
 			},
 			expectedFound: map[string]bool{
 				key1: true,  // key1 should still exist
@@ -411,3 +430,585 @@ func TestHashCollisionHandling(t *testing.T) {
 
 // We need to modify the approach since Go doesn't allow adding fields to structs at runtime
 // Let's use a different method for tracking the third key
+
+func TestResize(t *testing.T) {
+	ht := InitHashTable()
+	const n = 1000
+
+	// Grow: every key inserted so far must stay reachable while rehashes are in progress
+	for i := 0; i < n; i++ {
+		ht.Insert(fmt.Sprintf("key-%d", i))
+		for j := 0; j <= i; j += 37 {
+			if !ht.Search(fmt.Sprintf("key-%d", j)) {
+				t.Fatalf("After inserting %d keys, key-%d is missing", i+1, j)
+			}
+		}
+	}
+	if len(ht.buckets) <= ArraySize {
+		t.Errorf("Bucket count after %d inserts = %d, expected growth beyond %d", n, len(ht.buckets), ArraySize)
+	}
+	if load := float64(ht.count) / float64(len(ht.buckets)); ht.old == nil && load > DefaultGrowLoadFactor {
+		t.Errorf("Load factor after growth = %.2f, expected at most %.2f", load, DefaultGrowLoadFactor)
+	}
+
+	// Shrink: deleting every key returns the table to its minimum size
+	for i := 0; i < n; i++ {
+		if _, ok := ht.Delete(fmt.Sprintf("key-%d", i)); !ok {
+			t.Fatalf("Delete(key-%d) returned false", i)
+		}
+	}
+	for ht.old != nil {
+		ht.rehashStep()
+	}
+	if len(ht.buckets) != ArraySize {
+		t.Errorf("Bucket count after deleting all keys = %d, expected %d", len(ht.buckets), ArraySize)
+	}
+	if ht.count != 0 {
+		t.Errorf("Key count after deleting all keys = %d, expected 0", ht.count)
+	}
+}
+
+func TestIncrementalRehash(t *testing.T) {
+	ht := InitHashTable()
+
+	// Insert until a rehash starts
+	i := 0
+	for ; ht.old == nil; i++ {
+		ht.Insert(fmt.Sprintf("key-%d", i))
+	}
+	oldSize := len(ht.old)
+
+	// Every further insert migrates at most one batch of buckets
+	if ht.batch < rehashBatch {
+		t.Fatalf("Rehash batch = %d, expected at least %d", ht.batch, rehashBatch)
+	}
+	for ht.old != nil {
+		before := ht.rehashIdx
+		ht.Insert(fmt.Sprintf("key-%d", i))
+		i++
+		if ht.old != nil && ht.rehashIdx-before > ht.batch {
+			t.Fatalf("Insert migrated %d buckets, expected at most %d", ht.rehashIdx-before, ht.batch)
+		}
+	}
+
+	if i-oldSize > oldSize {
+		t.Errorf("Rehash of %d buckets took %d inserts", oldSize, i)
+	}
+	for j := 0; j < i; j++ {
+		if !ht.Search(fmt.Sprintf("key-%d", j)) {
+			t.Errorf("key-%d missing after rehash", j)
+		}
+	}
+}
+
+// TestRehashFinishesBeforeNextResize checks that no Insert or Delete has to finish the previous
+// rehash synchronously, whatever the sequence of grows and shrinks
+func TestRehashFinishesBeforeNextResize(t *testing.T) {
+	ht := InitHashTable()
+	resizes := 0
+
+	// step runs op and fails if it started a rehash while more than one batch of the previous
+	// one was left, which startRehash would then have had to migrate in one go
+	step := func(op func(string), i int) {
+		t.Helper()
+		current := ht.buckets
+		left := len(ht.old) - ht.rehashIdx
+		batch := ht.batch
+		op(fmt.Sprintf("key-%d", i))
+		if ht.old != nil && &ht.old[0] == &current[0] {
+			resizes++
+			if left > batch {
+				t.Fatalf("A resize started with %d old buckets left, more than the batch of %d", left, batch)
+			}
+		}
+	}
+	insert := func(key string) { ht.Insert(key) }
+	remove := func(key string) { ht.Delete(key) }
+
+	// Grow repeatedly
+	const n = 50000
+	for i := 0; i < n; i++ {
+		step(insert, i)
+	}
+	// Delete until a shrink starts, then grow straight away
+	d := 0
+	for ; ht.old == nil || len(ht.old) < len(ht.buckets); d++ {
+		step(remove, d)
+	}
+	for i := 0; i < d; i++ {
+		step(insert, i)
+	}
+	for i := n; i < 2*n; i++ {
+		step(insert, i)
+	}
+	// Shrink repeatedly, the first time straight after a grow
+	for i := 0; i < 2*n; i++ {
+		step(remove, i)
+	}
+
+	if resizes < 20 {
+		t.Errorf("Only %d resizes happened, expected the test to exercise many more", resizes)
+	}
+}
+
+func TestWithCapacity(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []Option
+		keys     int
+		wantSize int // Minimum expected initial bucket count
+	}{
+		{"Default capacity", nil, 5, ArraySize},
+		{"Capacity 1000", []Option{WithCapacity(1000)}, 1000, 1334},
+		{"Capacity with custom load factor", []Option{WithLoadFactors(0.25, 2), WithCapacity(1000)}, 1000, 501},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ht := New[string, struct{}](test.opts...)
+			if len(ht.buckets) < test.wantSize {
+				t.Errorf("Initial bucket count = %d, want at least %d", len(ht.buckets), test.wantSize)
+			}
+
+			// The requested number of keys fits without starting a rehash
+			size := len(ht.buckets)
+			for i := 0; i < test.keys; i++ {
+				ht.Insert(fmt.Sprintf("key-%d", i))
+				if ht.old != nil {
+					t.Fatalf("Rehash started after %d of %d keys", i+1, test.keys)
+				}
+			}
+			if len(ht.buckets) != size {
+				t.Errorf("Bucket count changed from %d to %d", size, len(ht.buckets))
+			}
+
+			// Deleting everything never shrinks below the initial size
+			for i := 0; i < test.keys; i++ {
+				ht.Delete(fmt.Sprintf("key-%d", i))
+			}
+			if len(ht.buckets) < size {
+				t.Errorf("Bucket count shrank to %d, below the initial %d", len(ht.buckets), size)
+			}
+		})
+	}
+}
+
+func TestInvalidLoadFactors(t *testing.T) {
+	tests := []struct {
+		name         string
+		shrink, grow float64
+	}{
+		{"Zero grow", 0, 0},
+		{"Negative shrink", -0.1, 0.75},
+		{"Shrink too close to grow", 0.5, 0.75},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("New(WithLoadFactors(%v, %v)) did not panic", test.shrink, test.grow)
+				}
+			}()
+			New[string, struct{}](WithLoadFactors(test.shrink, test.grow))
+		})
+	}
+}
+
+// benchmarkSizes are the table sizes used to show that lookups stay flat as the table grows
+var benchmarkSizes = []int{10, 1_000, 100_000, 1_000_000, 10_000_000}
+
+// benchmarkKeys returns n distinct keys
+func benchmarkKeys(n int) []string {
+	keys := make([]string, n)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("key-%d", i)
+	}
+	return keys
+}
+
+// BenchmarkSearch measures lookups of present keys in tables of increasing size
+// The time per lookup should stay roughly constant because resizing keeps the load factor bounded
+func BenchmarkSearch(b *testing.B) {
+	for _, n := range benchmarkSizes {
+		b.Run(fmt.Sprintf("keys=%d", n), func(b *testing.B) {
+			if testing.Short() && n > 100_000 {
+				b.Skip("skipping large table in short mode")
+			}
+			keys := benchmarkKeys(n)
+			ht := InitHashTable()
+			for _, key := range keys {
+				ht.Insert(key)
+			}
+
+			i := 0
+			for b.Loop() {
+				ht.Search(keys[i%n])
+				i++
+			}
+		})
+	}
+}
+
+// BenchmarkInsert measures inserts into a growing table, including the incremental rehash work
+// A fresh table is started every n inserts, so each op is one insert into a table of up to n keys
+func BenchmarkInsert(b *testing.B) {
+	for _, n := range benchmarkSizes {
+		b.Run(fmt.Sprintf("keys=%d", n), func(b *testing.B) {
+			if testing.Short() && n > 100_000 {
+				b.Skip("skipping large table in short mode")
+			}
+			keys := benchmarkKeys(n)
+
+			var ht *StringSet
+			i := 0
+			for b.Loop() {
+				if i%n == 0 {
+					ht = InitHashTable()
+				}
+				ht.Insert(keys[i%n])
+				i++
+			}
+		})
+	}
+}
+
+func TestPutGet(t *testing.T) {
+	type kv struct {
+		key   string
+		value int
+	}
+
+	tests := []struct {
+		name      string
+		puts      []kv // Put calls in order
+		getKey    string
+		wantValue int
+		wantFound bool
+		wantLen   int
+	}{
+		{
+			name:      "Get from empty table",
+			getKey:    "apple",
+			wantValue: 0,
+			wantFound: false,
+			wantLen:   0,
+		},
+		{
+			name:      "Get stored value",
+			puts:      []kv{{"apple", 1}, {"banana", 2}},
+			getKey:    "banana",
+			wantValue: 2,
+			wantFound: true,
+			wantLen:   2,
+		},
+		{
+			name:      "Put overwrites existing value",
+			puts:      []kv{{"apple", 1}, {"apple", 5}},
+			getKey:    "apple",
+			wantValue: 5,
+			wantFound: true,
+			wantLen:   1,
+		},
+		{
+			name:      "Get missing key",
+			puts:      []kv{{"apple", 1}},
+			getKey:    "grape",
+			wantValue: 0,
+			wantFound: false,
+			wantLen:   1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ht := New[string, int]()
+			for _, p := range test.puts {
+				ht.Put(p.key, p.value)
+			}
+
+			value, found := ht.Get(test.getKey)
+			if value != test.wantValue || found != test.wantFound {
+				t.Errorf("Get(%q) = (%d, %t), want (%d, %t)", test.getKey, value, found, test.wantValue, test.wantFound)
+			}
+			if got := ht.GetOrDefault(test.getKey, -1); test.wantFound && got != test.wantValue || !test.wantFound && got != -1 {
+				t.Errorf("GetOrDefault(%q, -1) = %d", test.getKey, got)
+			}
+			if ht.Len() != test.wantLen {
+				t.Errorf("Len() = %d, want %d", ht.Len(), test.wantLen)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	ht := New[string, int]()
+	increment := func(n int) int { return n + 1 }
+
+	// Counting words: missing keys start from the zero value
+	for _, word := range strings.Fields("the cat and the hat and the bat") {
+		ht.Update(word, increment)
+	}
+
+	expected := map[string]int{"the": 3, "and": 2, "cat": 1, "hat": 1, "bat": 1}
+	for word, count := range expected {
+		if got, _ := ht.Get(word); got != count {
+			t.Errorf("Count of %q = %d, want %d", word, got, count)
+		}
+	}
+	if ht.Len() != len(expected) {
+		t.Errorf("Len() = %d, want %d", ht.Len(), len(expected))
+	}
+
+	if got := ht.Update("the", func(n int) int { return n * 10 }); got != 30 {
+		t.Errorf("Update returned %d, want 30", got)
+	}
+}
+
+func TestDeleteReturnsValue(t *testing.T) {
+	type point struct{ x, y int }
+	ht := New[point, string]()
+	ht.Put(point{1, 2}, "a")
+	ht.Put(point{3, 4}, "b")
+
+	value, ok := ht.Delete(point{1, 2})
+	if value != "a" || !ok {
+		t.Errorf("Delete(existing) = (%q, %t), want (%q, true)", value, ok, "a")
+	}
+
+	value, ok = ht.Delete(point{1, 2})
+	if value != "" || ok {
+		t.Errorf("Delete(already deleted) = (%q, %t), want (\"\", false)", value, ok)
+	}
+
+	if ht.Len() != 1 || !ht.Search(point{3, 4}) {
+		t.Errorf("Table after delete has Len() = %d, want 1 with {3 4} present", ht.Len())
+	}
+}
+
+func TestValuesSurviveResize(t *testing.T) {
+	ht := New[int, int]()
+	const n = 5000
+
+	for i := 0; i < n; i++ {
+		ht.Put(i, i*i)
+	}
+	for i := 0; i < n; i += 2 {
+		ht.Delete(i)
+	}
+
+	if ht.Len() != n/2 {
+		t.Errorf("Len() = %d, want %d", ht.Len(), n/2)
+	}
+	for i := 0; i < n; i++ {
+		value, ok := ht.Get(i)
+		if wantOK := i%2 == 1; ok != wantOK || (ok && value != i*i) {
+			t.Fatalf("Get(%d) = (%d, %t), want (%d, %t)", i, value, ok, i*i, wantOK)
+		}
+	}
+}
+
+func TestInsertExistingKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		initial []string // Keys inserted before the call under test
+		key     string
+		wantErr error
+		wantLen int
+	}{
+		{name: "Insert new key", initial: nil, key: "ERIC", wantErr: nil, wantLen: 1},
+		{name: "Insert existing key", initial: []string{"ERIC"}, key: "ERIC", wantErr: ErrKeyExists, wantLen: 1},
+		{name: "Insert next to existing keys", initial: []string{"ERIC", "KENNY"}, key: "KYLE", wantErr: nil, wantLen: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ht := InitHashTable()
+			for _, key := range tt.initial {
+				if err := ht.Insert(key); err != nil {
+					t.Fatalf("Insert(%q) = %v, want nil", key, err)
+				}
+			}
+
+			err := ht.Insert(tt.key)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Insert(%q) = %v, want %v", tt.key, err, tt.wantErr)
+			}
+			if ht.Len() != tt.wantLen {
+				t.Errorf("Len() = %d, want %d", ht.Len(), tt.wantLen)
+			}
+		})
+	}
+}
+
+func TestUpsert(t *testing.T) {
+	ht := New[string, int]()
+
+	if inserted := ht.Upsert("ERIC", 10); !inserted {
+		t.Errorf("Upsert of a new key returned false, want true")
+	}
+	if inserted := ht.Upsert("ERIC", 11); inserted {
+		t.Errorf("Upsert of an existing key returned true, want false")
+	}
+	if value, _ := ht.Get("ERIC"); value != 11 || ht.Len() != 1 {
+		t.Errorf("After Upsert, Get(%q) = %d with Len() = %d, want 11 with Len() = 1", "ERIC", value, ht.Len())
+	}
+}
+
+func TestNoStdout(t *testing.T) {
+	// Swap stdout for a pipe while exercising every path that used to print
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	for _, ht := range []Table[string, int]{New[string, int](), NewOpen[string, int]()} {
+		ht.Insert("ERIC")
+		ht.Insert("ERIC")
+		ht.Delete("ERIC")
+		ht.Delete("ERIC")
+	}
+
+	w.Close()
+	if out, _ := io.ReadAll(r); len(out) > 0 {
+		t.Errorf("Package wrote %q to stdout", out)
+	}
+}
+
+func TestKeysAllClear(t *testing.T) {
+	backends := []struct {
+		name  string
+		table Table[string, int]
+	}{
+		{"chained", New[string, int]()},
+		{"open", NewOpen[string, int]()},
+		{"concurrent", NewConcurrent[string, int]()},
+	}
+
+	for _, backend := range backends {
+		t.Run(backend.name, func(t *testing.T) {
+			ht := backend.table
+			if keys := ht.Keys(); len(keys) != 0 {
+				t.Errorf("Keys() on empty table = %v, want none", keys)
+			}
+
+			// Enough keys to trigger resizing while they are inserted
+			want := map[string]int{}
+			for i := 0; i < 100; i++ {
+				key := fmt.Sprintf("key-%d", i)
+				ht.Put(key, i)
+				want[key] = i
+			}
+
+			keys := ht.Keys()
+			slices.Sort(keys)
+			if wantKeys := slices.Sorted(maps.Keys(want)); !slices.Equal(keys, wantKeys) {
+				t.Errorf("Keys() = %v, want %v", keys, wantKeys)
+			}
+
+			got := map[string]int{}
+			for k, v := range ht.All() {
+				if _, seen := got[k]; seen {
+					t.Errorf("All() yielded %q twice", k)
+				}
+				got[k] = v
+			}
+			if !maps.Equal(got, want) {
+				t.Errorf("All() = %v, want %v", got, want)
+			}
+
+			// Breaking out of the loop stops the iterator
+			visited := 0
+			for range ht.All() {
+				visited++
+				if visited == 5 {
+					break
+				}
+			}
+			if visited != 5 {
+				t.Errorf("All() visited %d entries after break at 5", visited)
+			}
+
+			ht.Clear()
+			if ht.Len() != 0 || len(ht.Keys()) != 0 || ht.Search("key-1") {
+				t.Errorf("After Clear, Len() = %d and Keys() = %v, want an empty table", ht.Len(), ht.Keys())
+			}
+			ht.Put("key-1", 1)
+			if v, ok := ht.Get("key-1"); !ok || v != 1 || ht.Len() != 1 {
+				t.Errorf("Put after Clear: Get = (%d, %v) with Len() = %d, want (1, true) with Len() = 1", v, ok, ht.Len())
+			}
+		})
+	}
+}
+
+// lenHasher hashes a key to its length, so tests can choose which keys share a bucket
+type lenHasher struct{}
+
+func (lenHasher) Hash(key string) uint64 { return uint64(len(key)) }
+
+func TestStats(t *testing.T) {
+	tests := []struct {
+		name    string
+		keys    []string
+		want    Stats
+		wantLen int
+	}{
+		{
+			name: "Empty table",
+			keys: nil,
+			want: Stats{Keys: 0, Buckets: ArraySize, LoadFactor: 0, LongestChain: 0, ChainLengths: []int{ArraySize}},
+		},
+		{
+			name: "Spread keys",
+			keys: []string{"a", "bb", "ccc"},
+			want: Stats{Keys: 3, Buckets: ArraySize, LoadFactor: 3.0 / ArraySize, LongestChain: 1, ChainLengths: []int{4, 3}},
+		},
+		{
+			name: "Colliding keys",
+			keys: []string{"a", "bb", "cc", "ddd", "eee", "fff"},
+			want: Stats{Keys: 6, Buckets: ArraySize, LoadFactor: 6.0 / ArraySize, LongestChain: 3, ChainLengths: []int{4, 1, 1, 1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// A grow threshold of 1 keeps every test case in the initial bucket array
+			ht := NewWithHasher[string, int](lenHasher{}, WithLoadFactors(0.125, 1))
+			for _, key := range tt.keys {
+				ht.Insert(key)
+			}
+
+			got := ht.Stats()
+			if got.Keys != tt.want.Keys || got.Buckets != tt.want.Buckets || got.LoadFactor != tt.want.LoadFactor ||
+				got.LongestChain != tt.want.LongestChain || !slices.Equal(got.ChainLengths, tt.want.ChainLengths) || got.Rehashing {
+				t.Errorf("Stats() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("During rehash", func(t *testing.T) {
+		ht := InitHashTable()
+		for i := 0; ht.old == nil; i++ {
+			ht.Insert(fmt.Sprintf("key-%d", i))
+		}
+
+		got := ht.Stats()
+		if !got.Rehashing || got.Keys != ht.Len() {
+			t.Errorf("Stats() = %+v, want Rehashing with %d keys", got, ht.Len())
+		}
+		// Every key is counted exactly once across the old and new bucket arrays
+		total := 0
+		for n, buckets := range got.ChainLengths {
+			total += n * buckets
+		}
+		if total != ht.Len() {
+			t.Errorf("Chain lengths add up to %d keys, want %d", total, ht.Len())
+		}
+		if want := len(ht.buckets) + len(ht.old) - ht.rehashIdx; got.Buckets != want {
+			t.Errorf("Stats().Buckets = %d, want %d", got.Buckets, want)
+		}
+	})
+}
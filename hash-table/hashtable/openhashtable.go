@@ -0,0 +1,274 @@
+package hashtable
+
+import (
+	"fmt"
+	"iter"
+	"math/bits"
+)
+
+// minSlots is the default (and minimum) number of slots in a new open-addressing table
+// Slot counts are powers of two so the slot index is a mask of the hash instead of a modulo
+const minSlots = 8
+
+// OpenHashTable is a hash table that stores keys and values directly in a slot array
+// using open addressing with Robin Hood linear probing:
+//   - A key that collides is placed in the next free slot, so lookups walk a contiguous
+//     run of memory instead of chasing bucket pointers, and inserts do not allocate
+//   - On insert, a key that is further from its home slot than the resident key takes the slot
+//     and the resident moves on. This keeps probe lengths short and lets a search stop as soon
+//     as it meets a key closer to home than the one it is looking for
+//   - Delete shifts the following keys of the run back by one slot instead of leaving a
+//     tombstone, so deleted keys never slow down later lookups
+//
+// It has the same API as HashTable. Resizing is not incremental: crossing a load-factor
+// threshold rehashes the whole table in one call
+type OpenHashTable[K comparable, V any] struct {
+	slots  []slot[K, V] // Slot array; its length is a power of two
+	count  int          // Number of keys stored
+	hasher Hasher[K]    // Hash function chosen at construction
+
+	minSlots         int     // The slot array never shrinks below this size
+	growLoadFactor   float64 // Load factor above which the slot array doubles
+	shrinkLoadFactor float64 // Load factor below which the slot array halves
+}
+
+// slot holds one key of an OpenHashTable
+type slot[K comparable, V any] struct {
+	hash  uint64 // Cached hash of the key, so resizing never calls the Hasher again
+	key   K
+	value V
+	dist  int32 // Distance from the key's home slot plus one; 0 marks an empty slot
+}
+
+// NewOpen creates a new OpenHashTable configured by the given options, hashing keys with MapHash
+// It panics if the load-factor thresholds are invalid; the grow threshold must also be below 1
+func NewOpen[K comparable, V any](opts ...Option) *OpenHashTable[K, V] {
+	return NewOpenWithHasher[K, V](MapHash[K]{}, opts...)
+}
+
+// NewOpenWithHasher creates a new OpenHashTable that hashes keys with h
+// It panics if the load-factor thresholds are invalid; the grow threshold must also be below 1,
+// since an open-addressing table cannot hold more keys than slots
+func NewOpenWithHasher[K comparable, V any](h Hasher[K], opts ...Option) *OpenHashTable[K, V] {
+	c := buildConfig(opts)
+	if c.growLoadFactor >= 1 {
+		panic(fmt.Sprintf("hashtable: grow load factor %v must be below 1 for open addressing", c.growLoadFactor))
+	}
+
+	size := max(minSlots, 1<<bits.Len(uint(float64(c.capacity)/c.growLoadFactor)))
+	return &OpenHashTable[K, V]{
+		slots:            make([]slot[K, V], size),
+		hasher:           h,
+		minSlots:         size,
+		growLoadFactor:   c.growLoadFactor,
+		shrinkLoadFactor: c.shrinkLoadFactor,
+	}
+}
+
+// Insert adds a key to the hash table with the zero value, if it is not already present
+// This is the set-style counterpart of Put, which also stores a value
+// Time complexity: O(1) on average, amortized over resizes
+// Returns:
+//   - error: nil if the key was added, or an error wrapping ErrKeyExists if it was already present
+func (ht *OpenHashTable[K, V]) Insert(key K) error {
+	if ht.find(key) >= 0 {
+		return fmt.Errorf("insert %v: %w", key, ErrKeyExists)
+	}
+	var zero V
+	ht.add(key, zero)
+	return nil
+}
+
+// Upsert stores the value for the key, replacing any existing value
+// Time complexity: O(1) on average, amortized over resizes
+// Returns:
+//   - bool: True if the key was newly inserted, false if an existing value was replaced
+func (ht *OpenHashTable[K, V]) Upsert(key K, value V) bool {
+	if i := ht.find(key); i >= 0 {
+		ht.slots[i].value = value
+		return false
+	}
+	ht.add(key, value)
+	return true
+}
+
+// Put stores the value for the key, replacing any existing value
+// It is Upsert for callers that do not need to know whether the key was new
+// Time complexity: O(1) on average, amortized over resizes
+func (ht *OpenHashTable[K, V]) Put(key K, value V) {
+	ht.Upsert(key, value)
+}
+
+// Search returns true if the key exists in the hash table
+// Time complexity: O(1) on average
+func (ht *OpenHashTable[K, V]) Search(key K) bool {
+	return ht.find(key) >= 0
+}
+
+// Get returns the value stored for the key
+// Time complexity: O(1) on average
+// Returns:
+//   - V: The stored value, or the zero value if the key is absent
+//   - bool: True if the key was found
+func (ht *OpenHashTable[K, V]) Get(key K) (V, bool) {
+	if i := ht.find(key); i >= 0 {
+		return ht.slots[i].value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// GetOrDefault returns the value stored for the key, or def if the key is absent
+// Time complexity: O(1) on average
+func (ht *OpenHashTable[K, V]) GetOrDefault(key K, def V) V {
+	if value, ok := ht.Get(key); ok {
+		return value
+	}
+	return def
+}
+
+// Update replaces the value for the key with fn applied to it and returns the new value
+// If the key is absent, fn receives the zero value and the result is inserted
+// Time complexity: O(1) on average, plus the cost of fn
+func (ht *OpenHashTable[K, V]) Update(key K, fn func(V) V) V {
+	if i := ht.find(key); i >= 0 {
+		ht.slots[i].value = fn(ht.slots[i].value)
+		return ht.slots[i].value
+	}
+
+	var zero V
+	value := fn(zero)
+	ht.add(key, value)
+	return value
+}
+
+// Delete removes a key from the hash table
+// Time complexity: O(1) on average, amortized over resizes
+// Returns:
+//   - V: The value that was stored for the key, or the zero value if the key was absent
+//   - bool: True if the key was found and deleted
+func (ht *OpenHashTable[K, V]) Delete(key K) (V, bool) {
+	i := ht.find(key)
+	if i < 0 {
+		var zero V
+		return zero, false
+	}
+
+	value := ht.slots[i].value
+	ht.removeAt(i)
+	ht.count--
+
+	size := len(ht.slots)
+	if float64(ht.count)/float64(size) < ht.shrinkLoadFactor && size > ht.minSlots {
+		ht.resize(size / 2)
+	}
+	return value, true
+}
+
+// Len returns the number of keys stored in the hash table
+// Time complexity: O(1)
+func (ht *OpenHashTable[K, V]) Len() int {
+	return ht.count
+}
+
+// Keys returns the keys stored in the hash table, in slot order
+// Time complexity: O(s) where s is the number of slots
+func (ht *OpenHashTable[K, V]) Keys() []K {
+	keys := make([]K, 0, ht.count)
+	for k := range ht.All() {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// All returns an iterator over the keys and values in the hash table, in slot order
+// The table must not be modified while iterating
+// Time complexity: O(s) for a full iteration, where s is the number of slots
+func (ht *OpenHashTable[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for i := range ht.slots {
+			if ht.slots[i].dist != 0 && !yield(ht.slots[i].key, ht.slots[i].value) {
+				return
+			}
+		}
+	}
+}
+
+// Clear removes every key and returns the slot array to its initial size
+// Time complexity: O(1), plus the allocation of the new slot array
+func (ht *OpenHashTable[K, V]) Clear() {
+	ht.slots = make([]slot[K, V], ht.minSlots)
+	ht.count = 0
+}
+
+// find returns the index of the slot holding the key, or -1 if the key is absent
+func (ht *OpenHashTable[K, V]) find(key K) int {
+	h := ht.hasher.Hash(key)
+	mask := len(ht.slots) - 1
+	i := int(h) & mask
+	for dist := int32(1); ; dist++ {
+		s := &ht.slots[i]
+		// An empty slot, or a key closer to its home than we are to ours, ends the search:
+		// Robin Hood insertion would have placed our key before it
+		if s.dist < dist {
+			return -1
+		}
+		if s.dist == dist && s.hash == h && s.key == key {
+			return i
+		}
+		i = (i + 1) & mask
+	}
+}
+
+// add inserts a key that is known to be absent, growing the table first if needed
+func (ht *OpenHashTable[K, V]) add(key K, value V) {
+	if float64(ht.count+1)/float64(len(ht.slots)) > ht.growLoadFactor {
+		ht.resize(len(ht.slots) * 2)
+	}
+	ht.place(slot[K, V]{hash: ht.hasher.Hash(key), key: key, value: value})
+	ht.count++
+}
+
+// place stores an entry in its Robin Hood position, displacing keys that are closer to home
+// The table must have at least one empty slot
+func (ht *OpenHashTable[K, V]) place(entry slot[K, V]) {
+	mask := len(ht.slots) - 1
+	i := int(entry.hash) & mask
+	entry.dist = 1
+	for {
+		s := &ht.slots[i]
+		if s.dist == 0 {
+			*s = entry
+			return
+		}
+		if s.dist < entry.dist {
+			// Take from the rich: the resident is closer to home, so it moves on instead
+			*s, entry = entry, *s
+		}
+		entry.dist++
+		i = (i + 1) & mask
+	}
+}
+
+// removeAt empties slot i and shifts the rest of its probe run back by one slot
+// A run ends at an empty slot or at a key that already sits in its home slot
+func (ht *OpenHashTable[K, V]) removeAt(i int) {
+	mask := len(ht.slots) - 1
+	for next := (i + 1) & mask; ht.slots[next].dist > 1; next = (next + 1) & mask {
+		ht.slots[i] = ht.slots[next]
+		ht.slots[i].dist--
+		i = next
+	}
+	ht.slots[i] = slot[K, V]{} // Clear the key and value so they can be garbage collected
+}
+
+// resize moves every key into a new slot array of the given size
+func (ht *OpenHashTable[K, V]) resize(size int) {
+	old := ht.slots
+	ht.slots = make([]slot[K, V], size)
+	for i := range old {
+		if old[i].dist != 0 {
+			ht.place(old[i])
+		}
+	}
+}
@@ -0,0 +1,315 @@
+package hashtable
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// constHasher sends every key to the same home slot, so all keys form a single probe run
+type constHasher struct{}
+
+func (constHasher) Hash(string) uint64 { return 0 }
+
+// checkOpenTable verifies the Robin Hood invariants of an OpenHashTable:
+// every stored distance matches the key's home slot, runs have no gaps, and the key count is right
+func checkOpenTable(t *testing.T, ht *OpenHashTable[string, int]) {
+	t.Helper()
+
+	mask := len(ht.slots) - 1
+	count := 0
+	for i, s := range ht.slots {
+		if s.dist == 0 {
+			continue
+		}
+		count++
+
+		home := int(ht.hasher.Hash(s.key)) & mask
+		if want := int32((i-home)&mask) + 1; s.dist != want {
+			t.Errorf("Slot %d holds %q with distance %d, want %d", i, s.key, s.dist, want)
+		}
+		// A key that is not in its home slot must follow an occupied slot, or a search would stop early
+		if prev := ht.slots[(i-1)&mask]; s.dist > 1 && prev.dist < s.dist-1 {
+			t.Errorf("Slot %d holds %q at distance %d after a slot at distance %d", i, s.key, s.dist, prev.dist)
+		}
+	}
+	if count != ht.count {
+		t.Errorf("Occupied slots = %d, want %d", count, ht.count)
+	}
+}
+
+func TestOpenHashTable(t *testing.T) {
+	ht := NewOpen[string, int]()
+
+	// Operations on an empty table
+	if ht.Search("ERIC") {
+		t.Errorf("Expected to not find key 'ERIC' in empty hash table")
+	}
+	if _, deleted := ht.Delete("ERIC"); deleted {
+		t.Errorf("Delete on an empty hash table should return false")
+	}
+
+	keys := []string{"ERIC", "KENNY", "KYLE", "STAN", "BUTTERS", "RANDY"}
+	for i, key := range keys {
+		ht.Put(key, i)
+	}
+	if err := ht.Insert("ERIC"); !errors.Is(err, ErrKeyExists) {
+		t.Errorf("Insert of an existing key returned %v, want ErrKeyExists", err) // The value is kept
+	}
+	ht.Update("KENNY", func(v int) int { return v + 10 })
+
+	for i, key := range keys {
+		want := i
+		if key == "KENNY" {
+			want += 10
+		}
+		if got, ok := ht.Get(key); !ok || got != want {
+			t.Errorf("Get(%q) = (%d, %v), want (%d, true)", key, got, ok, want)
+		}
+	}
+	if got := ht.GetOrDefault("CARTMAN", -1); got != -1 {
+		t.Errorf("GetOrDefault(%q) = %d, want -1", "CARTMAN", got)
+	}
+
+	if value, deleted := ht.Delete("KYLE"); !deleted || value != 2 {
+		t.Errorf("Delete(%q) = (%d, %v), want (2, true)", "KYLE", value, deleted)
+	}
+	if _, deleted := ht.Delete("KYLE"); deleted {
+		t.Errorf("Deleting %q twice should return false", "KYLE")
+	}
+	if ht.Search("KYLE") {
+		t.Errorf("Expected key 'KYLE' to be deleted from hash table")
+	}
+	if ht.Len() != len(keys)-1 {
+		t.Errorf("Len() = %d, want %d", ht.Len(), len(keys)-1)
+	}
+	checkOpenTable(t, ht)
+}
+
+func TestOpenHashTableDeleteShiftsBack(t *testing.T) {
+	tests := []struct {
+		name   string
+		delete []string // Keys to delete, in order
+	}{
+		{"Delete head of run", []string{"a"}},
+		{"Delete middle of run", []string{"c"}},
+		{"Delete end of run", []string{"e"}},
+		{"Delete several", []string{"b", "d", "a"}},
+		{"Delete all", []string{"c", "a", "e", "b", "d"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Every key has the same home slot, so they are stored as one run
+			ht := NewOpenWithHasher[string, int](constHasher{})
+			keys := []string{"a", "b", "c", "d", "e"}
+			for i, key := range keys {
+				ht.Put(key, i)
+			}
+
+			deleted := map[string]bool{}
+			for _, key := range tt.delete {
+				if _, ok := ht.Delete(key); !ok {
+					t.Fatalf("Delete(%q) = false, want true", key)
+				}
+				deleted[key] = true
+				checkOpenTable(t, ht)
+			}
+
+			// The remaining keys are still reachable without tombstones in the way
+			for i, key := range keys {
+				got, ok := ht.Get(key)
+				if ok == deleted[key] || (ok && got != i) {
+					t.Errorf("Get(%q) = (%d, %v) after deleting %v", key, got, ok, tt.delete)
+				}
+			}
+		})
+	}
+}
+
+func TestOpenHashTableMatchesMap(t *testing.T) {
+	// Random operations over a small key space, so the table keeps growing, shrinking and colliding
+	r := rand.New(rand.NewPCG(1, 2))
+	ht := NewOpen[string, int]()
+	want := map[string]int{}
+
+	for i := 0; i < 20_000; i++ {
+		key := fmt.Sprintf("key-%d", r.IntN(500))
+		switch r.IntN(3) {
+		case 0:
+			ht.Put(key, i)
+			want[key] = i
+		case 1:
+			_, gotOK := ht.Delete(key)
+			_, wantOK := want[key]
+			if gotOK != wantOK {
+				t.Fatalf("Delete(%q) = %v, want %v", key, gotOK, wantOK)
+			}
+			delete(want, key)
+		case 2:
+			got, gotOK := ht.Get(key)
+			value, wantOK := want[key]
+			if got != value || gotOK != wantOK {
+				t.Fatalf("Get(%q) = (%d, %v), want (%d, %v)", key, got, gotOK, value, wantOK)
+			}
+		}
+		if ht.Len() != len(want) {
+			t.Fatalf("Len() = %d, want %d", ht.Len(), len(want))
+		}
+	}
+	checkOpenTable(t, ht)
+}
+
+func TestOpenHashTableResize(t *testing.T) {
+	ht := NewOpen[string, int]()
+	const n = 1000
+
+	for i := 0; i < n; i++ {
+		ht.Put(fmt.Sprintf("key-%d", i), i)
+	}
+	if load := float64(ht.count) / float64(len(ht.slots)); load > DefaultGrowLoadFactor {
+		t.Errorf("Load factor after growth = %.2f, expected at most %.2f", load, DefaultGrowLoadFactor)
+	}
+	checkOpenTable(t, ht)
+
+	for i := 0; i < n; i++ {
+		if _, ok := ht.Delete(fmt.Sprintf("key-%d", i)); !ok {
+			t.Fatalf("Delete(key-%d) returned false", i)
+		}
+	}
+	if len(ht.slots) != minSlots {
+		t.Errorf("Slot count after deleting all keys = %d, expected %d", len(ht.slots), minSlots)
+	}
+
+	// A pre-sized table holds its capacity without resizing
+	ht = NewOpen[string, int](WithCapacity(n))
+	size := len(ht.slots)
+	for i := 0; i < n; i++ {
+		ht.Put(fmt.Sprintf("key-%d", i), i)
+	}
+	if len(ht.slots) != size {
+		t.Errorf("Slot count changed from %d to %d", size, len(ht.slots))
+	}
+}
+
+func TestOpenHashTableInvalidLoadFactors(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("NewOpen(WithLoadFactors(0.25, 1)) did not panic")
+		}
+	}()
+	NewOpen[string, int](WithLoadFactors(0.25, 1))
+}
+
+// backendSizes are the table sizes used to compare the hash table backends
+var backendSizes = []int{1_000, 100_000, 1_000_000}
+
+// benchmarkBackends runs bench against the chained table, the open-addressing table and the builtin map
+func benchmarkBackends(b *testing.B, bench func(b *testing.B, newTable func() Table[string, int], keys []string)) {
+	for _, n := range backendSizes {
+		keys := benchmarkKeys(n)
+		backends := []struct {
+			name     string
+			newTable func() Table[string, int]
+		}{
+			{"chained", func() Table[string, int] { return New[string, int]() }},
+			{"open", func() Table[string, int] { return NewOpen[string, int]() }},
+			{"map", func() Table[string, int] { return builtinMap{} }},
+		}
+		for _, backend := range backends {
+			b.Run(fmt.Sprintf("%s/keys=%d", backend.name, n), func(b *testing.B) {
+				if testing.Short() && n > 100_000 {
+					b.Skip("skipping large table in short mode")
+				}
+				bench(b, backend.newTable, keys)
+			})
+		}
+	}
+}
+
+// BenchmarkBackendSearch measures lookups of present keys in each backend
+func BenchmarkBackendSearch(b *testing.B) {
+	benchmarkBackends(b, func(b *testing.B, newTable func() Table[string, int], keys []string) {
+		ht := newTable()
+		for i, key := range keys {
+			ht.Put(key, i)
+		}
+
+		i := 0
+		for b.Loop() {
+			ht.Get(keys[i%len(keys)])
+			i++
+		}
+	})
+}
+
+// BenchmarkBackendInsert measures inserts into a growing table of each backend
+// A fresh table is started every len(keys) inserts
+func BenchmarkBackendInsert(b *testing.B) {
+	benchmarkBackends(b, func(b *testing.B, newTable func() Table[string, int], keys []string) {
+		var ht Table[string, int]
+		i := 0
+		for b.Loop() {
+			if i%len(keys) == 0 {
+				ht = newTable()
+			}
+			ht.Put(keys[i%len(keys)], i)
+			i++
+		}
+	})
+}
+
+// BenchmarkBackendDelete measures deleting and re-inserting present keys in each backend,
+// which keeps the table size steady
+func BenchmarkBackendDelete(b *testing.B) {
+	benchmarkBackends(b, func(b *testing.B, newTable func() Table[string, int], keys []string) {
+		ht := newTable()
+		for i, key := range keys {
+			ht.Put(key, i)
+		}
+
+		i := 0
+		for b.Loop() {
+			key := keys[i%len(keys)]
+			ht.Delete(key)
+			ht.Put(key, i)
+			i++
+		}
+	})
+}
+
+// builtinMap adapts the builtin map to the Table interface for benchmarks
+// Every method has the same map-access cost as the direct operation
+type builtinMap map[string]int
+
+func (m builtinMap) Insert(key string) error {
+	if _, ok := m[key]; ok {
+		return ErrKeyExists
+	}
+	m[key] = 0
+	return nil
+}
+func (m builtinMap) Upsert(key string, value int) bool {
+	_, ok := m[key]
+	m[key] = value
+	return !ok
+}
+func (m builtinMap) Put(key string, value int)  { m[key] = value }
+func (m builtinMap) Search(key string) bool     { _, ok := m[key]; return ok }
+func (m builtinMap) Get(key string) (int, bool) { v, ok := m[key]; return v, ok }
+func (m builtinMap) GetOrDefault(key string, def int) int {
+	if v, ok := m[key]; ok {
+		return v
+	}
+	return def
+}
+func (m builtinMap) Update(key string, fn func(int) int) int { m[key] = fn(m[key]); return m[key] }
+func (m builtinMap) Delete(key string) (int, bool)           { v, ok := m[key]; delete(m, key); return v, ok }
+func (m builtinMap) Len() int                                { return len(m) }
+func (m builtinMap) Keys() []string                          { return slices.Collect(maps.Keys(m)) }
+func (m builtinMap) All() iter.Seq2[string, int]             { return maps.All(m) }
+func (m builtinMap) Clear()                                  { clear(m) }
@@ -1,6 +1,9 @@
 package maxheap
 
-import "fmt"
+import (
+	"fmt"
+	"iter"
+)
 
 // MaxHeap represents a max heap data structure
 // A max heap is a complete binary tree where the value of each node is greater than or equal to
@@ -31,8 +34,7 @@ func (h *MaxHeap) Insert(value int) {
 // This operation removes the root (maximum element) and restores the heap property
 func (h *MaxHeap) Extract() (int, bool) {
 	if len(h.array) == 0 {
-		fmt.Println("Heap is empty")
-		return 0, false
+		return 0, false // Return zero value and false for empty heap
 	}
 
 	// The maximum value in a max heap is always at the root (index 0)
@@ -85,6 +87,20 @@ func (h *MaxHeap) GetArray() []int {
 	return result
 }
 
+// All returns an iterator over the elements of the heap in their internal array order
+// The first element is the maximum, but the rest are not sorted; use Extract to consume them in order
+// The heap must not be modified while iterating
+// Time complexity: O(n) for a full iteration, O(1) per step
+func (h *MaxHeap) All() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for _, v := range h.array {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
 // maxHeapifyUp maintains the heap property going upward from a node
 // Used during insertion to position a new element correctly
 // It compares a node with its parent and swaps them if the heap property is violated
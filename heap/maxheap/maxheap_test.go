@@ -2,6 +2,7 @@ package maxheap
 
 import (
 	"reflect"
+	"slices"
 	"testing"
 )
 
@@ -570,3 +571,23 @@ func isMaxHeapProperty(arr []int) bool {
 	}
 	return true
 }
+
+func TestAll(t *testing.T) {
+	h := InitMaxHeap()
+	if got := slices.Collect(h.All()); len(got) != 0 {
+		t.Errorf("All() on empty heap = %v, want no elements", got)
+	}
+
+	h.BuildHeap([]int{40, 10, 30, 50, 20})
+	got := slices.Collect(h.All())
+	if !slices.Equal(got, h.GetArray()) {
+		t.Errorf("All() = %v, want array order %v", got, h.GetArray())
+	}
+
+	for v := range h.All() {
+		if want, _ := h.GetMax(); v != want {
+			t.Errorf("First element from All() = %d, want %d", v, want)
+		}
+		break
+	}
+}
@@ -1,6 +1,7 @@
 package minheap
 
 import (
+	"slices"
 	"testing"
 )
 
@@ -136,3 +137,23 @@ func TestGetArray(t *testing.T) {
 		}
 	}
 }
+
+func TestAll(t *testing.T) {
+	h := InitMinHeap()
+	if got := slices.Collect(h.All()); len(got) != 0 {
+		t.Errorf("All() on empty heap = %v, want no elements", got)
+	}
+
+	h.BuildHeap([]int{40, 10, 30, 50, 20})
+	got := slices.Collect(h.All())
+	if !slices.Equal(got, h.GetArray()) {
+		t.Errorf("All() = %v, want array order %v", got, h.GetArray())
+	}
+
+	for v := range h.All() {
+		if want, _ := h.GetMin(); v != want {
+			t.Errorf("First element from All() = %d, want %d", v, want)
+		}
+		break
+	}
+}
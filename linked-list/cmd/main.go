@@ -11,14 +11,14 @@ import (
 // main function serves as an entry point and demonstrates the linked list operations
 func main() {
 	// Initialize an empty linked list
-	mylist := linkedlist.LinkedList{}
+	mylist := linkedlist.LinkedList[int]{}
 
 	// Create nodes with different integer values
 	// Each node contains data and initially has no next pointer
-	node1 := &linkedlist.Node{Data: 10}
-	node2 := &linkedlist.Node{Data: 20}
-	node3 := &linkedlist.Node{Data: 30}
-	node4 := &linkedlist.Node{Data: 40}
+	node1 := &linkedlist.Node[int]{Data: 10}
+	node2 := &linkedlist.Node[int]{Data: 20}
+	node3 := &linkedlist.Node[int]{Data: 30}
+	node4 := &linkedlist.Node[int]{Data: 40}
 
 	// Build the list by prepending nodes
 	// Note: Since we're using Prepend, the final list will be in reverse order (40->30->20->10)
@@ -29,78 +29,135 @@ func main() {
 
 	// Print the original list to console
 	fmt.Println("Original list:")
-	mylist.PrintListData() // Expected output: 40 30 20 10
+	fmt.Println(mylist) // Expected output: [40 30 20 10]
 
 	// Demonstrate deletion of a node by value
 	// This removes the first occurrence of the value 40 from the list
-	mylist.DeleteWithValue(40)
+	linkedlist.DeleteWithValue(&mylist, 40)
 	fmt.Println("After deleting 40:")
-	mylist.PrintListData() // Expected output: 30 20 10
+	fmt.Println(mylist) // Expected output: [30 20 10]
 
 	// === Additional demonstration of linked list operations ===
 	fmt.Println("\n--- Additional Demo Operations ---")
 
 	// Create a second linked list for more demonstrations
-	newList := linkedlist.LinkedList{}
+	newList := linkedlist.LinkedList[int]{}
 
 	// Demonstrate building a list by adding elements one by one
 	// Note how the list order changes as we prepend each element
 	fmt.Println("Building a sorted list:")
 
 	// Add first element to the empty list
-	newList.Prepend(&linkedlist.Node{Data: 50})
+	newList.Prepend(&linkedlist.Node[int]{Data: 50})
 	fmt.Println("After adding 50:")
-	newList.PrintListData() // Expected output: 50
+	fmt.Println(newList) // Expected output: [50]
 
 	// Prepend adds to the beginning, so 30 will be first
-	newList.Prepend(&linkedlist.Node{Data: 30})
+	newList.Prepend(&linkedlist.Node[int]{Data: 30})
 	fmt.Println("After adding 30:")
-	newList.PrintListData() // Expected output: 30 50
+	fmt.Println(newList) // Expected output: [30 50]
 
 	// Similarly, 10 will become the first element
-	newList.Prepend(&linkedlist.Node{Data: 10})
+	newList.Prepend(&linkedlist.Node[int]{Data: 10})
 	fmt.Println("After adding 10:")
-	newList.PrintListData() // Expected output: 10 30 50
+	fmt.Println(newList) // Expected output: [10 30 50]
 
 	// Demonstrate deletion of an element in the middle of the list
 	// This tests the linked list's ability to reconnect nodes when a middle node is removed
 	fmt.Println("\nDeleting middle element (30):")
-	newList.DeleteWithValue(30)
-	newList.PrintListData() // Expected output: 10 50
+	linkedlist.DeleteWithValue(&newList, 30)
+	fmt.Println(newList) // Expected output: [10 50]
 
 	// Demonstrate adding multiple elements and showing how the list structure evolves
 	fmt.Println("\nAdding more elements:")
-	newList.Prepend(&linkedlist.Node{Data: 25}) // Add 25 to the beginning
-	newList.Prepend(&linkedlist.Node{Data: 35}) // Add 35 to the beginning
-	newList.Prepend(&linkedlist.Node{Data: 15}) // Add 15 to the beginning
-	newList.PrintListData()                     // Expected output: 15 35 25 10 50
+	newList.Prepend(&linkedlist.Node[int]{Data: 25}) // Add 25 to the beginning
+	newList.Prepend(&linkedlist.Node[int]{Data: 35}) // Add 35 to the beginning
+	newList.Prepend(&linkedlist.Node[int]{Data: 15}) // Add 15 to the beginning
+	fmt.Println(newList)                             // Expected output: [15 35 25 10 50]
 
 	// Demonstrate the behavior when trying to delete a value that doesn't exist in the list
 	// The list should remain unchanged after this operation
 	fmt.Println("\nTrying to delete non-existent value (100):")
-	newList.DeleteWithValue(100) // 100 is not in the list, so this should have no effect
-	newList.PrintListData()      // Expected output: 15 35 25 10 50 (unchanged)
+	linkedlist.DeleteWithValue(&newList, 100) // 100 is not in the list, so this should have no effect
+	fmt.Println(newList)                      // Expected output: [15 35 25 10 50] (unchanged)
 
 	// Demonstrate accessing the list's length property
 	// This shows the current count of nodes in the list
 	fmt.Printf("\nList length: %d\n", newList.Length) // Expected output: 5
 
+	// The %+v verb also shows the length and the address of every node
+	fmt.Printf("%+v\n", newList) // Expected output: LinkedList{length: 5, nodes: [15(0x...) 35(0x...) ...]}
+
 	// === Demonstrate edge case: operations on an empty list ===
 	fmt.Println("\n--- Deleting from Empty List Demo ---")
 
 	// Create a new empty linked list for edge case testing
-	emptyList := linkedlist.LinkedList{}
+	emptyList := linkedlist.LinkedList[int]{}
 
 	// Show the initial state of the empty list
 	fmt.Println("Empty list:")
-	emptyList.PrintListData() // Expected output: (empty line, no elements to print)
+	fmt.Println(emptyList) // Expected output: []
 
 	// Demonstrate the behavior when trying to delete from an empty list
 	// This tests the linked list's robustness with edge cases
 	fmt.Println("\nAttempting to delete from empty list:")
-	emptyList.DeleteWithValue(10) // The DeleteWithValue method should handle the empty list case gracefully
-	emptyList.PrintListData()     // Expected output: (empty line, still empty)
+	linkedlist.DeleteWithValue(&emptyList, 10) // DeleteWithValue should handle the empty list case gracefully
+	fmt.Println(emptyList)                     // Expected output: [] (still empty)
 
 	// Verify that the length of the empty list is still 0
 	fmt.Printf("\nEmpty list length: %d\n", emptyList.Length) // Expected output: 0
+
+	// === Demonstrate positional operations ===
+	fmt.Println("\n--- Positional Operations Demo ---")
+
+	// Append adds to the tail in constant time, so this list keeps insertion order
+	posList := linkedlist.LinkedList[int]{}
+	posList.Append(&linkedlist.Node[int]{Data: 1})
+	posList.Append(&linkedlist.Node[int]{Data: 2})
+	posList.Append(&linkedlist.Node[int]{Data: 4})
+	fmt.Println(posList) // Expected output: [1 2 4]
+
+	// Insert 3 so that it ends up at index 2
+	posList.InsertAt(2, &linkedlist.Node[int]{Data: 3})
+	fmt.Println(posList) // Expected output: [1 2 3 4]
+
+	// Read and replace values by index
+	second, _ := posList.Get(1)
+	fmt.Printf("Value at index 1: %d\n", second) // Expected output: 2
+	posList.Set(1, 20)
+	fmt.Printf("Index of 20: %d\n", linkedlist.IndexOf(&posList, 20)) // Expected output: 1
+
+	// Remove by index; out-of-range indexes are reported as errors
+	removed, _ := posList.RemoveAt(0)
+	fmt.Printf("Removed %d: ", removed)
+	fmt.Println(posList) // Expected output: Removed 1: [20 3 4]
+	if _, err := posList.RemoveAt(10); err != nil {
+		fmt.Println("Error:", err) // Expected output: Error: remove at 10 in list of length 3: index out of range
+	}
+
+	// === Demonstrate the doubly linked list ===
+	fmt.Println("\n--- Doubly Linked List Demo ---")
+
+	// Push at both ends; keep the returned node so it can be unlinked later in O(1)
+	dlist := linkedlist.DoublyLinkedList[string]{}
+	dlist.PushBack("b")
+	middle := dlist.PushBack("c")
+	dlist.PushFront("a")
+	dlist.PushBack("d")
+
+	// Walk forward using Next and backward using Prev
+	for n := dlist.Head; n != nil; n = n.Next {
+		fmt.Print(n.Data, " ")
+	}
+	fmt.Println() // Expected output: a b c d
+	for n := dlist.Tail; n != nil; n = n.Prev {
+		fmt.Print(n.Data, " ")
+	}
+	fmt.Println() // Expected output: d c b a
+
+	// Unlink a held node without searching for its predecessor, then pop from both ends
+	dlist.Remove(middle)
+	front, _ := dlist.PopFront()
+	back, _ := dlist.PopBack()
+	fmt.Printf("Popped %s and %s, %d left\n", front, back, dlist.Length) // Expected output: Popped a and d, 1 left
 }
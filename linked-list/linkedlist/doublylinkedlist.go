@@ -0,0 +1,205 @@
+package linkedlist
+
+import (
+	"fmt"
+	"io"
+	"iter"
+	"strings"
+)
+
+// DoublyNode represents a node in a doubly linked list
+// Each node contains data of type T and pointers to both its neighbours
+type DoublyNode[T any] struct {
+	Data T              // The value stored in the node
+	Prev *DoublyNode[T] // Pointer to the previous node in the list
+	Next *DoublyNode[T] // Pointer to the next node in the list
+
+	list *DoublyLinkedList[T] // The list this node belongs to, nil once removed
+}
+
+// DoublyLinkedList represents a doubly linked list data structure holding values of type T
+// It maintains references to both ends of the list, so all operations at either end are O(1),
+// and any node the caller holds can be unlinked without searching for its predecessor
+type DoublyLinkedList[T any] struct {
+	Head   *DoublyNode[T] // Pointer to the first node in the list
+	Tail   *DoublyNode[T] // Pointer to the last node in the list
+	Length int            // Number of nodes in the list
+}
+
+// PushFront adds a value at the beginning of the list
+// Time Complexity: O(1) - constant time operation
+// Returns:
+//   - *DoublyNode[T]: The new node, which can later be passed to Remove or MoveToFront
+func (l *DoublyLinkedList[T]) PushFront(value T) *DoublyNode[T] {
+	n := &DoublyNode[T]{Data: value, Next: l.Head, list: l}
+	if l.Head == nil {
+		l.Tail = n // The first node is also the last one
+	} else {
+		l.Head.Prev = n
+	}
+	l.Head = n
+	l.Length++
+	return n
+}
+
+// PushBack adds a value at the end of the list
+// Time Complexity: O(1) - constant time operation
+// Returns:
+//   - *DoublyNode[T]: The new node, which can later be passed to Remove or MoveToFront
+func (l *DoublyLinkedList[T]) PushBack(value T) *DoublyNode[T] {
+	n := &DoublyNode[T]{Data: value, Prev: l.Tail, list: l}
+	if l.Tail == nil {
+		l.Head = n // The first node is also the last one
+	} else {
+		l.Tail.Next = n
+	}
+	l.Tail = n
+	l.Length++
+	return n
+}
+
+// PopFront removes and returns the first value in the list
+// Time Complexity: O(1) - constant time operation
+// Returns:
+//   - T: The first value in the list
+//   - bool: True if the list was not empty, false otherwise
+func (l *DoublyLinkedList[T]) PopFront() (T, bool) {
+	if l.Head == nil {
+		var zero T
+		return zero, false // Return zero value and false for empty list
+	}
+	return l.Remove(l.Head), true
+}
+
+// PopBack removes and returns the last value in the list
+// Time Complexity: O(1) - constant time operation
+// Returns:
+//   - T: The last value in the list
+//   - bool: True if the list was not empty, false otherwise
+func (l *DoublyLinkedList[T]) PopBack() (T, bool) {
+	if l.Tail == nil {
+		var zero T
+		return zero, false // Return zero value and false for empty list
+	}
+	return l.Remove(l.Tail), true
+}
+
+// Remove unlinks a node from the list and returns its data
+// Nodes that do not belong to this list (or were already removed) are left untouched
+// Time Complexity: O(1) - the node's neighbours are reached through its own pointers
+// Parameters:
+//   - n: A node previously returned by PushFront or PushBack on this list
+func (l *DoublyLinkedList[T]) Remove(n *DoublyNode[T]) T {
+	if n.list != l {
+		return n.Data
+	}
+
+	l.unlink(n)
+	n.Prev, n.Next, n.list = nil, nil, nil // Avoid keeping the rest of the list reachable
+	return n.Data
+}
+
+// MoveToFront moves a node to the beginning of the list without reallocating it
+// This is the typical "mark as most recently used" step of an LRU cache
+// Time Complexity: O(1) - constant time operation
+func (l *DoublyLinkedList[T]) MoveToFront(n *DoublyNode[T]) {
+	if n.list != l || l.Head == n {
+		return
+	}
+
+	l.unlink(n)
+	n.Prev = nil
+	n.Next = l.Head
+	if l.Head == nil {
+		l.Tail = n
+	} else {
+		l.Head.Prev = n
+	}
+	l.Head = n
+	l.Length++
+}
+
+// All returns an iterator over the values in the list, from head to tail
+// The node being visited may be removed during iteration; other modifications are not supported
+// Time Complexity: O(n) for a full iteration, O(1) per step
+func (l *DoublyLinkedList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for current := l.Head; current != nil; {
+			next := current.Next // Saved first so the current node can be removed by the caller
+			if !yield(current.Data) {
+				return
+			}
+			current = next
+		}
+	}
+}
+
+// Backward returns an iterator over the values in the list, from tail to head
+// The node being visited may be removed during iteration; other modifications are not supported
+// Time Complexity: O(n) for a full iteration, O(1) per step
+func (l *DoublyLinkedList[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for current := l.Tail; current != nil; {
+			prev := current.Prev // Saved first so the current node can be removed by the caller
+			if !yield(current.Data) {
+				return
+			}
+			current = prev
+		}
+	}
+}
+
+// String returns the values in the list from head to tail, e.g. "[10 20 30]"
+// This method implements the Stringer interface for better debugging and printing
+// Time Complexity: O(n) where n is the length of the list
+func (l DoublyLinkedList[T]) String() string {
+	var sb strings.Builder
+	l.WriteTo(&sb)
+	return sb.String()
+}
+
+// WriteTo writes the same representation as String to w
+// This method implements the io.WriterTo interface
+func (l DoublyLinkedList[T]) WriteTo(w io.Writer) (int64, error) {
+	return writeValues(w, "%v", l.All())
+}
+
+// Format implements the fmt.Formatter interface
+//   - %v and %s print the compact form returned by String
+//   - %+v also prints the length and the address of every node,
+//     e.g. "DoublyLinkedList{length: 2, nodes: [10(0xc000010000) 20(0xc000010018)]}"
+//   - Any other verb formats each value with that verb and its flags, as LinkedList.Format does
+func (l DoublyLinkedList[T]) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('+'):
+		fmt.Fprintf(f, "DoublyLinkedList{length: %d, nodes: [", l.Length)
+		for current := l.Head; current != nil; current = current.Next {
+			if current != l.Head {
+				io.WriteString(f, " ")
+			}
+			fmt.Fprintf(f, "%v(%p)", current.Data, current)
+		}
+		io.WriteString(f, "]}")
+	case verb == 'v' || verb == 's':
+		l.WriteTo(f)
+	default:
+		writeValues(f, fmt.FormatString(f, verb), l.All())
+	}
+}
+
+// unlink detaches a node from its neighbours and updates the list ends and length
+func (l *DoublyLinkedList[T]) unlink(n *DoublyNode[T]) {
+	if n.Prev == nil {
+		l.Head = n.Next // Removing the head
+	} else {
+		n.Prev.Next = n.Next
+	}
+
+	if n.Next == nil {
+		l.Tail = n.Prev // Removing the tail
+	} else {
+		n.Next.Prev = n.Prev
+	}
+
+	l.Length--
+}
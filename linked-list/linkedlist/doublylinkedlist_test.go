@@ -0,0 +1,313 @@
+package linkedlist
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+// checkDoublyList verifies the length and the order of values when walking the list in both directions
+func checkDoublyList(t *testing.T, list *DoublyLinkedList[int], want []int) {
+	t.Helper()
+
+	if list.Length != len(want) {
+		t.Errorf("List length = %d, want %d", list.Length, len(want))
+	}
+
+	// Walk forward from the head
+	current := list.Head
+	for i, expected := range want {
+		if current == nil {
+			t.Errorf("Forward walk ended prematurely at position %d", i)
+			return
+		}
+		if current.Data != expected {
+			t.Errorf("Forward node at position %d = %d, want %d", i, current.Data, expected)
+		}
+		current = current.Next
+	}
+	if current != nil {
+		t.Errorf("List has extra nodes beyond expected length")
+	}
+
+	// Walk backward from the tail
+	current = list.Tail
+	for i := len(want) - 1; i >= 0; i-- {
+		if current == nil {
+			t.Errorf("Backward walk ended prematurely at position %d", i)
+			return
+		}
+		if current.Data != want[i] {
+			t.Errorf("Backward node at position %d = %d, want %d", i, current.Data, want[i])
+		}
+		current = current.Prev
+	}
+	if current != nil {
+		t.Errorf("List has extra nodes before the head")
+	}
+}
+
+// TestDoublyPush tests adding values at both ends of the list
+func TestDoublyPush(t *testing.T) {
+	tests := []struct {
+		name       string
+		operations []struct {
+			op    string // "front" or "back"
+			value int    // Value to push
+		}
+		wantOrder []int // Expected order of values in list
+	}{
+		{
+			name: "Push front to empty list",
+			operations: []struct {
+				op    string
+				value int
+			}{
+				{op: "front", value: 10},
+			},
+			wantOrder: []int{10},
+		},
+		{
+			name: "Push back to empty list",
+			operations: []struct {
+				op    string
+				value int
+			}{
+				{op: "back", value: 10},
+			},
+			wantOrder: []int{10},
+		},
+		{
+			name: "Mixed pushes",
+			operations: []struct {
+				op    string
+				value int
+			}{
+				{op: "back", value: 20},
+				{op: "front", value: 10},
+				{op: "back", value: 30},
+				{op: "front", value: 5},
+			},
+			wantOrder: []int{5, 10, 20, 30},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := &DoublyLinkedList[int]{}
+			for _, op := range tt.operations {
+				switch op.op {
+				case "front":
+					list.PushFront(op.value)
+				case "back":
+					list.PushBack(op.value)
+				}
+			}
+
+			checkDoublyList(t, list, tt.wantOrder)
+		})
+	}
+}
+
+// TestDoublyPop tests removing values from both ends of the list
+func TestDoublyPop(t *testing.T) {
+	tests := []struct {
+		name      string
+		initial   []int  // Values pushed to the back in order
+		pop       string // "front" or "back"
+		wantValue int    // Expected popped value
+		wantOK    bool   // Expected success flag
+		wantOrder []int  // Expected order of values after popping
+	}{
+		{name: "Pop front from empty list", initial: []int{}, pop: "front", wantOK: false, wantOrder: []int{}},
+		{name: "Pop back from empty list", initial: []int{}, pop: "back", wantOK: false, wantOrder: []int{}},
+		{name: "Pop front of single node", initial: []int{10}, pop: "front", wantValue: 10, wantOK: true, wantOrder: []int{}},
+		{name: "Pop back of single node", initial: []int{10}, pop: "back", wantValue: 10, wantOK: true, wantOrder: []int{}},
+		{name: "Pop front", initial: []int{10, 20, 30}, pop: "front", wantValue: 10, wantOK: true, wantOrder: []int{20, 30}},
+		{name: "Pop back", initial: []int{10, 20, 30}, pop: "back", wantValue: 30, wantOK: true, wantOrder: []int{10, 20}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := &DoublyLinkedList[int]{}
+			for _, val := range tt.initial {
+				list.PushBack(val)
+			}
+
+			var got int
+			var ok bool
+			switch tt.pop {
+			case "front":
+				got, ok = list.PopFront()
+			case "back":
+				got, ok = list.PopBack()
+			}
+
+			if ok != tt.wantOK || got != tt.wantValue {
+				t.Errorf("Pop %s = (%d, %v), want (%d, %v)", tt.pop, got, ok, tt.wantValue, tt.wantOK)
+			}
+
+			checkDoublyList(t, list, tt.wantOrder)
+		})
+	}
+}
+
+// TestDoublyRemove tests unlinking nodes the caller already holds
+func TestDoublyRemove(t *testing.T) {
+	tests := []struct {
+		name      string
+		initial   []int // Values pushed to the back in order
+		remove    []int // Indexes (into initial) of the nodes to remove, in order
+		wantOrder []int // Expected order of values after removal
+	}{
+		{name: "Remove only node", initial: []int{10}, remove: []int{0}, wantOrder: []int{}},
+		{name: "Remove head", initial: []int{10, 20, 30}, remove: []int{0}, wantOrder: []int{20, 30}},
+		{name: "Remove middle", initial: []int{10, 20, 30}, remove: []int{1}, wantOrder: []int{10, 30}},
+		{name: "Remove tail", initial: []int{10, 20, 30}, remove: []int{2}, wantOrder: []int{10, 20}},
+		{name: "Remove same node twice", initial: []int{10, 20, 30}, remove: []int{1, 1}, wantOrder: []int{10, 30}},
+		{name: "Remove all nodes", initial: []int{10, 20, 30}, remove: []int{1, 0, 2}, wantOrder: []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := &DoublyLinkedList[int]{}
+			nodes := make([]*DoublyNode[int], len(tt.initial))
+			for i, val := range tt.initial {
+				nodes[i] = list.PushBack(val)
+			}
+
+			for _, idx := range tt.remove {
+				if got := list.Remove(nodes[idx]); got != tt.initial[idx] {
+					t.Errorf("Remove returned %d, want %d", got, tt.initial[idx])
+				}
+			}
+
+			checkDoublyList(t, list, tt.wantOrder)
+		})
+	}
+
+	t.Run("Remove node from another list", func(t *testing.T) {
+		list := &DoublyLinkedList[int]{}
+		other := &DoublyLinkedList[int]{}
+		list.PushBack(10)
+		foreign := other.PushBack(20)
+
+		list.Remove(foreign)
+
+		checkDoublyList(t, list, []int{10})
+		checkDoublyList(t, other, []int{20})
+	})
+}
+
+// TestDoublyMoveToFront tests moving held nodes to the head of the list
+func TestDoublyMoveToFront(t *testing.T) {
+	tests := []struct {
+		name      string
+		initial   []int // Values pushed to the back in order
+		move      int   // Index (into initial) of the node to move
+		wantOrder []int // Expected order of values after the move
+	}{
+		{name: "Move head", initial: []int{10, 20, 30}, move: 0, wantOrder: []int{10, 20, 30}},
+		{name: "Move middle", initial: []int{10, 20, 30}, move: 1, wantOrder: []int{20, 10, 30}},
+		{name: "Move tail", initial: []int{10, 20, 30}, move: 2, wantOrder: []int{30, 10, 20}},
+		{name: "Single node", initial: []int{10}, move: 0, wantOrder: []int{10}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := &DoublyLinkedList[int]{}
+			nodes := make([]*DoublyNode[int], len(tt.initial))
+			for i, val := range tt.initial {
+				nodes[i] = list.PushBack(val)
+			}
+
+			list.MoveToFront(nodes[tt.move])
+
+			checkDoublyList(t, list, tt.wantOrder)
+		})
+	}
+
+	t.Run("Values that are not comparable", func(t *testing.T) {
+		list := &DoublyLinkedList[[]string]{}
+		list.PushBack([]string{"a"})
+		b := list.PushBack([]string{"b", "c"})
+
+		list.MoveToFront(b)
+
+		if got := slices.Collect(list.All()); len(got) != 2 || !slices.Equal(got[0], []string{"b", "c"}) {
+			t.Errorf("List after MoveToFront = %q, want [[b c] [a]]", got)
+		}
+	})
+}
+
+// TestDoublyIteration tests iterating over the list in both directions
+func TestDoublyIteration(t *testing.T) {
+	tests := []struct {
+		name         string
+		initial      []int // Values pushed to the back in order
+		wantForward  []int // Expected values from All
+		wantBackward []int // Expected values from Backward
+	}{
+		{name: "Empty list", initial: []int{}, wantForward: nil, wantBackward: nil},
+		{name: "Single node", initial: []int{10}, wantForward: []int{10}, wantBackward: []int{10}},
+		{name: "Several nodes", initial: []int{10, 20, 30}, wantForward: []int{10, 20, 30}, wantBackward: []int{30, 20, 10}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := &DoublyLinkedList[int]{}
+			for _, val := range tt.initial {
+				list.PushBack(val)
+			}
+
+			if got := slices.Collect(list.All()); !slices.Equal(got, tt.wantForward) {
+				t.Errorf("All() = %v, want %v", got, tt.wantForward)
+			}
+			if got := slices.Collect(list.Backward()); !slices.Equal(got, tt.wantBackward) {
+				t.Errorf("Backward() = %v, want %v", got, tt.wantBackward)
+			}
+		})
+	}
+
+	t.Run("Remove visited node", func(t *testing.T) {
+		list := &DoublyLinkedList[int]{}
+		nodes := map[int]*DoublyNode[int]{}
+		for _, val := range []int{10, 20, 30} {
+			nodes[val] = list.PushBack(val)
+		}
+
+		var got []int
+		for v := range list.All() {
+			got = append(got, v)
+			list.Remove(nodes[v])
+		}
+
+		if !slices.Equal(got, []int{10, 20, 30}) {
+			t.Errorf("All() while removing = %v, want [10 20 30]", got)
+		}
+		checkDoublyList(t, list, []int{})
+	})
+}
+
+// TestDoublyFormatting tests String and the fmt verbs supported by Format
+func TestDoublyFormatting(t *testing.T) {
+	list := &DoublyLinkedList[int]{}
+	if got := list.String(); got != "[]" {
+		t.Errorf("String() on empty list = %q, want %q", got, "[]")
+	}
+
+	first := list.PushBack(10)
+	second := list.PushBack(20)
+
+	if got := fmt.Sprintf("%v", list); got != "[10 20]" {
+		t.Errorf("Sprintf(%%v) = %q, want %q", got, "[10 20]")
+	}
+	if got := fmt.Sprintf("%x", list); got != "[a 14]" {
+		t.Errorf("Sprintf(%%x) = %q, want %q", got, "[a 14]")
+	}
+
+	want := fmt.Sprintf("DoublyLinkedList{length: 2, nodes: [10(%p) 20(%p)]}", first, second)
+	if got := fmt.Sprintf("%+v", list); got != want {
+		t.Errorf("Sprintf(%%+v) = %q, want %q", got, want)
+	}
+}
@@ -1,77 +1,710 @@
 package linkedlist
 
 import (
+	"errors"
 	"fmt"
+	"io"
+	"iter"
+	"os"
+	"strings"
 )
 
+// ErrIndexOutOfRange is returned by positional operations when the index is outside the list
+var ErrIndexOutOfRange = errors.New("index out of range")
+
+// ErrCycle is returned by Validate when following Next pointers never reaches nil
+var ErrCycle = errors.New("list contains a cycle")
+
+// ErrLengthMismatch is returned by Validate when Length differs from the real node count
+var ErrLengthMismatch = errors.New("length does not match node count")
+
 // Node represents a node in a linked list
-// Each node contains data and a pointer to the next node
-type Node struct {
-	Data int   // The value stored in the node
-	Next *Node // Pointer to the next node in the list
+// Each node contains data of type T and a pointer to the next node
+type Node[T any] struct {
+	Data T        // The value stored in the node
+	Next *Node[T] // Pointer to the next node in the list
 }
 
-// LinkedList represents a linked list data structure
+// LinkedList represents a linked list data structure holding values of type T
 // It maintains a reference to the head of the list and tracks its length
-type LinkedList struct {
-	Head   *Node // Pointer to the first node in the list
-	Length int   // Number of nodes in the list
+// T can be any type; the operations that compare values with ==, such as DeleteWithValue and
+// IndexOf, are package-level functions that require a comparable T, and each has a Func method
+// variant that works for every T
+type LinkedList[T any] struct {
+	Head   *Node[T] // Pointer to the first node in the list
+	Length int      // Number of nodes in the list
+	tail   *Node[T] // Pointer to the last node in the list, used by Append
 }
 
+// IntNode is a Node holding int values
+// It keeps the original int-based API available as linkedlist.IntNode{Data: 10}
+type IntNode = Node[int]
+
+// IntLinkedList is a LinkedList holding int values
+// Existing int-based callers can migrate by replacing LinkedList{} with IntLinkedList{}
+type IntLinkedList = LinkedList[int]
+
 // Prepend adds a new node at the beginning of the list
 // Time Complexity: O(1) - constant time operation
 // Parameters:
 //   - n: The node to be added at the beginning of the list
-func (l *LinkedList) Prepend(n *Node) {
+func (l *LinkedList[T]) Prepend(n *Node[T]) {
 	second := l.Head     // Save the current head
 	l.Head = n           // Set the new head to the provided node
 	l.Head.Next = second // Link the new head to the previous head
-	l.Length++           // Increment the list length
+	if second == nil {
+		l.tail = n // The first node is also the last one
+	}
+	l.Length++ // Increment the list length
+}
+
+// Append adds a new node at the end of the list
+// Time Complexity: O(1) - the tail pointer is tracked, so no traversal is needed
+// Parameters:
+//   - n: The node to be added at the end of the list
+func (l *LinkedList[T]) Append(n *Node[T]) {
+	n.Next = nil
+	if l.Head == nil {
+		l.Head = n
+		l.tail = n
+		l.Length++
+		return
+	}
+
+	last := l.last()
+	last.Next = n
+	l.tail = n
+	l.Length++
+}
+
+// InsertAt inserts a node so that it ends up at the given index
+// Valid indexes are 0 through Length (inserting at Length appends)
+// Time Complexity: O(n) in general, O(1) at the head or tail
+// Parameters:
+//   - index: The position the new node will occupy
+//   - n: The node to insert
+//
+// Returns:
+//   - error: ErrIndexOutOfRange if index is negative or greater than Length
+func (l *LinkedList[T]) InsertAt(index int, n *Node[T]) error {
+	if index < 0 || index > l.Length {
+		return fmt.Errorf("insert at %d in list of length %d: %w", index, l.Length, ErrIndexOutOfRange)
+	}
+
+	if index == 0 {
+		l.Prepend(n)
+		return nil
+	}
+	if index == l.Length {
+		l.Append(n)
+		return nil
+	}
+
+	previous := l.nodeAt(index - 1)
+	n.Next = previous.Next
+	previous.Next = n
+	l.Length++
+	return nil
+}
+
+// RemoveAt removes the node at the given index and returns its data
+// Time Complexity: O(n) in general, O(1) at the head
+// Parameters:
+//   - index: The position of the node to remove
+//
+// Returns:
+//   - T: The data of the removed node
+//   - error: ErrIndexOutOfRange if index is negative or not less than Length
+func (l *LinkedList[T]) RemoveAt(index int) (T, error) {
+	var zero T
+	if index < 0 || index >= l.Length {
+		return zero, fmt.Errorf("remove at %d in list of length %d: %w", index, l.Length, ErrIndexOutOfRange)
+	}
+
+	if index == 0 {
+		removed := l.Head
+		l.Head = removed.Next
+		if l.Head == nil {
+			l.tail = nil
+		}
+		l.Length--
+		return removed.Data, nil
+	}
+
+	previous := l.nodeAt(index - 1)
+	removed := previous.Next
+	previous.Next = removed.Next
+	if removed == l.tail {
+		l.tail = previous
+	}
+	l.Length--
+	return removed.Data, nil
+}
+
+// Get returns the data stored at the given index
+// Time Complexity: O(n) where n is the index
+// Returns:
+//   - T: The data at the index
+//   - error: ErrIndexOutOfRange if index is negative or not less than Length
+func (l *LinkedList[T]) Get(index int) (T, error) {
+	var zero T
+	if index < 0 || index >= l.Length {
+		return zero, fmt.Errorf("get %d in list of length %d: %w", index, l.Length, ErrIndexOutOfRange)
+	}
+	return l.nodeAt(index).Data, nil
+}
+
+// Set replaces the data stored at the given index
+// Time Complexity: O(n) where n is the index
+// Returns:
+//   - error: ErrIndexOutOfRange if index is negative or not less than Length
+func (l *LinkedList[T]) Set(index int, value T) error {
+	if index < 0 || index >= l.Length {
+		return fmt.Errorf("set %d in list of length %d: %w", index, l.Length, ErrIndexOutOfRange)
+	}
+	l.nodeAt(index).Data = value
+	return nil
+}
+
+// IndexOf returns the index of the first node of the list holding value, or -1 if there is none
+// Time Complexity: O(n) where n is the length of the list
+func IndexOf[T comparable](l *LinkedList[T], value T) int {
+	return l.IndexFunc(func(data T) bool { return data == value })
+}
+
+// IndexFunc returns the index of the first node whose data satisfies match, or -1 if there is none
+// Time Complexity: O(n) where n is the length of the list
+func (l *LinkedList[T]) IndexFunc(match func(T) bool) int {
+	current := l.Head
+	for i := 0; current != nil; i++ {
+		if match(current.Data) {
+			return i
+		}
+		current = current.Next
+	}
+	return -1
+}
+
+// Contains reports whether any node of the list holds value
+// Time Complexity: O(n) where n is the length of the list
+func Contains[T comparable](l *LinkedList[T], value T) bool {
+	return IndexOf(l, value) != -1
+}
+
+// ContainsFunc reports whether the data of any node satisfies match
+// Time Complexity: O(n) where n is the length of the list
+func (l *LinkedList[T]) ContainsFunc(match func(T) bool) bool {
+	return l.IndexFunc(match) != -1
+}
+
+// DeleteAll removes every node of the list holding value and returns how many were removed
+// Time Complexity: O(n) where n is the length of the list
+func DeleteAll[T comparable](l *LinkedList[T], value T) int {
+	return l.DeleteAllFunc(func(data T) bool { return data == value })
 }
 
-// PrintListData prints all the values in the linked list
+// DeleteAllFunc removes every node whose data satisfies match and returns how many were removed
 // Time Complexity: O(n) where n is the length of the list
-// This method traverses the entire list and prints each node's data
-func (l LinkedList) PrintListData() {
-	toPrint := l.Head               // Start at the head
-	for i := 0; i < l.Length; i++ { // Iterate through the list
-		fmt.Printf("%d ", toPrint.Data) // Print the current node's data
-		toPrint = toPrint.Next          // Move to the next node
+func (l *LinkedList[T]) DeleteAllFunc(match func(T) bool) int {
+	removed := 0
+
+	// Drop matching nodes from the front so the head is a keeper (or nil)
+	for l.Head != nil && match(l.Head.Data) {
+		l.Head = l.Head.Next
+		removed++
+	}
+
+	// Unlink matching nodes after the head, remembering the last node kept
+	var last *Node[T]
+	current := l.Head
+	for current != nil {
+		for current.Next != nil && match(current.Next.Data) {
+			current.Next = current.Next.Next
+			removed++
+		}
+		last = current
+		current = current.Next
 	}
-	fmt.Println() // Print a newline at the end
+
+	l.tail = last
+	l.Length -= removed
+	return removed
 }
 
-// DeleteWithValue removes the first node with the specified value
+// All returns an iterator over the values in the list, from head to tail
+// The list must not be modified while iterating
+// Time Complexity: O(n) for a full iteration, O(1) per step
+func (l *LinkedList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for current := l.Head; current != nil; current = current.Next {
+			if !yield(current.Data) {
+				return
+			}
+		}
+	}
+}
+
+// Indexed returns an iterator over index/value pairs in the list, from head to tail
+// The list must not be modified while iterating
+// Time Complexity: O(n) for a full iteration, O(1) per step
+func (l *LinkedList[T]) Indexed() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for current := l.Head; current != nil; current = current.Next {
+			if !yield(i, current.Data) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// String returns the values in the list from head to tail, e.g. "[10 20 30]"
+// This method implements the Stringer interface for better debugging and printing
+// Time Complexity: O(n) where n is the length of the list
+func (l LinkedList[T]) String() string {
+	var sb strings.Builder
+	l.WriteTo(&sb)
+	return sb.String()
+}
+
+// WriteTo writes the same representation as String to w
+// This method implements the io.WriterTo interface
+// Returns:
+//   - int64: The number of bytes written
+//   - error: Any error returned by w
+func (l LinkedList[T]) WriteTo(w io.Writer) (int64, error) {
+	return writeValues(w, "%v", l.All())
+}
+
+// Format implements the fmt.Formatter interface
+//   - %v and %s print the compact form returned by String
+//   - %+v also prints the length and the address of every node,
+//     e.g. "LinkedList{length: 2, nodes: [10(0xc000010000) 20(0xc000010018)]}"
+//   - Any other verb formats each value with that verb and its flags, e.g. %x prints "[a 14]",
+//     and a value that does not support the verb prints as fmt reports it, e.g. "%!t(int=10)"
+func (l LinkedList[T]) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('+'):
+		fmt.Fprintf(f, "LinkedList{length: %d, nodes: [", l.Length)
+		for current := l.Head; current != nil; current = current.Next {
+			if current != l.Head {
+				io.WriteString(f, " ")
+			}
+			fmt.Fprintf(f, "%v(%p)", current.Data, current)
+		}
+		io.WriteString(f, "]}")
+	case verb == 'v' || verb == 's':
+		l.WriteTo(f)
+	default:
+		writeValues(f, fmt.FormatString(f, verb), l.All())
+	}
+}
+
+// writeValues writes values as a space-separated list in square brackets, each formatted with format
+// It is shared by the String, WriteTo and Format methods of both list types
+func writeValues[T any](w io.Writer, format string, values iter.Seq[T]) (int64, error) {
+	var total int64
+	write := func(format string, a ...any) error {
+		n, err := fmt.Fprintf(w, format, a...)
+		total += int64(n)
+		return err
+	}
+
+	if err := write("["); err != nil {
+		return total, err
+	}
+	first := true
+	for v := range values {
+		if !first {
+			if err := write(" "); err != nil {
+				return total, err
+			}
+		}
+		if err := write(format, v); err != nil {
+			return total, err
+		}
+		first = false
+	}
+	err := write("]")
+	return total, err
+}
+
+// nodeAt returns the node at the given index
+// The caller must ensure 0 <= index < Length
+func (l *LinkedList[T]) nodeAt(index int) *Node[T] {
+	current := l.Head
+	for i := 0; i < index; i++ {
+		current = current.Next
+	}
+	return current
+}
+
+// last returns the last node of a non-empty list
+// It uses the tracked tail when it is still valid and falls back to walking the list,
+// which can happen if a caller linked nodes through the exported Head and Next fields
+func (l *LinkedList[T]) last() *Node[T] {
+	if l.tail != nil && l.tail.Next == nil {
+		return l.tail
+	}
+	current := l.Head
+	for current.Next != nil {
+		current = current.Next
+	}
+	l.tail = current
+	return current
+}
+
+// PrintListData prints the values in the linked list to stdout in the form returned by String,
+// followed by a newline
+// Time Complexity: O(n) where n is the length of the list
+// Like String, it walks the nodes until the end of the chain rather than trusting Length
+//
+// Deprecated: PrintListData always writes to stdout. Use String, WriteTo or
+// fmt.Print(list) instead.
+func (l LinkedList[T]) PrintListData() {
+	l.WriteTo(os.Stdout)
+	fmt.Println()
+}
+
+// DeleteWithValue removes the first node of the list with the specified value
 // Time Complexity:
 //   - Best case: O(1) if the value is at the head
 //   - Worst case: O(n) if the value is at the end or not found
 //
 // Parameters:
-//   - value: The integer value to search for and delete
-func (l *LinkedList) DeleteWithValue(value int) {
+//   - l: The list to delete from
+//   - value: The value to search for and delete
+func DeleteWithValue[T comparable](l *LinkedList[T], value T) {
+	l.DeleteFunc(func(data T) bool { return data == value })
+}
+
+// DeleteFunc removes the first node whose data satisfies the match function
+// This is useful when equality is defined by a subset of fields, e.g. matching a struct by ID,
+// and for element types that cannot be compared with == at all
+// Time Complexity:
+//   - Best case: O(1) if the match is at the head
+//   - Worst case: O(n) if the match is at the end or not found
+//
+// Parameters:
+//   - match: Reports whether a node's data is the one to delete
+func (l *LinkedList[T]) DeleteFunc(match func(T) bool) {
 	// Handle empty list case
 	if l.Length == 0 {
 		return // Nothing to delete in an empty list
 	}
 
 	// Handle case where the head contains the value
-	if l.Head.Data == value {
+	if match(l.Head.Data) {
 		l.Head = l.Head.Next // Set head to the second node
-		l.Length--           // Decrement the length
+		if l.Head == nil {
+			l.tail = nil // The list is now empty
+		}
+		l.Length-- // Decrement the length
 		return
 	}
 
 	// Search for the node before the one with the target value
 	previousToDelete := l.Head
-	for previousToDelete.Next.Data != value {
-		// Check if we've reached the end of the list
-		if previousToDelete.Next.Next == nil {
-			return // Value not found in the list
+	for previousToDelete.Next != nil {
+		if match(previousToDelete.Next.Data) {
+			// Remove the node by updating the Next pointer to skip it
+			if previousToDelete.Next == l.tail {
+				l.tail = previousToDelete // The tail was removed
+			}
+			previousToDelete.Next = previousToDelete.Next.Next
+			l.Length-- // Decrement the length
+			return
 		}
 		previousToDelete = previousToDelete.Next
 	}
+	// Value not found in the list
+}
+
+// HasCycle reports whether following Next pointers from the head loops back on itself
+// It uses Floyd's tortoise and hare algorithm: a fast pointer moving two steps at a time
+// will eventually meet a slow pointer moving one step at a time if and only if there is a cycle
+// Time Complexity: O(n), no extra allocations
+func (l *LinkedList[T]) HasCycle() bool {
+	return l.meetingPoint() != nil
+}
+
+// CycleStart returns the first node of the cycle, or nil if the list has no cycle
+// After the two pointers of Floyd's algorithm meet, a pointer restarted from the head and
+// one continuing from the meeting point, both moving one step at a time, meet at the cycle start
+// Time Complexity: O(n), no extra allocations
+func (l *LinkedList[T]) CycleStart() *Node[T] {
+	meet := l.meetingPoint()
+	if meet == nil {
+		return nil
+	}
+
+	fromHead := l.Head
+	for fromHead != meet {
+		fromHead = fromHead.Next
+		meet = meet.Next
+	}
+	return fromHead
+}
+
+// meetingPoint returns the node where the slow and fast pointers meet, or nil if there is no cycle
+func (l *LinkedList[T]) meetingPoint() *Node[T] {
+	slow, fast := l.Head, l.Head
+	for fast != nil && fast.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+		if slow == fast {
+			return slow
+		}
+	}
+	return nil
+}
+
+// Reverse reverses the order of the nodes in place
+// The list must not contain a cycle; use Validate to check lists built by hand
+// Time Complexity: O(n), no extra allocations
+func (l *LinkedList[T]) Reverse() {
+	var previous *Node[T]
+	current := l.Head
+	l.tail = current // The old head becomes the new tail
+	for current != nil {
+		next := current.Next
+		current.Next = previous
+		previous = current
+		current = next
+	}
+	l.Head = previous
+}
+
+// Middle returns the middle node of the list, or nil if the list is empty
+// For an even number of nodes the second of the two middle nodes is returned
+// The list must not contain a cycle
+// Time Complexity: O(n), no extra allocations
+func (l *LinkedList[T]) Middle() *Node[T] {
+	slow, fast := l.Head, l.Head
+	for fast != nil && fast.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+	}
+	return slow
+}
+
+// NthFromEnd returns the node n positions from the end, where n = 1 is the last node
+// It walks a lead pointer n nodes ahead and then advances both pointers together,
+// so it does not rely on Length being accurate
+// The list must not contain a cycle
+// Time Complexity: O(n), no extra allocations
+// Returns:
+//   - *Node[T]: The node n positions from the end
+//   - error: ErrIndexOutOfRange if n is less than 1 or greater than the number of nodes
+func (l *LinkedList[T]) NthFromEnd(n int) (*Node[T], error) {
+	if n < 1 {
+		return nil, fmt.Errorf("node %d from end: %w", n, ErrIndexOutOfRange)
+	}
+
+	lead := l.Head
+	for i := 0; i < n; i++ {
+		if lead == nil {
+			return nil, fmt.Errorf("node %d from end in list of %d nodes: %w", n, i, ErrIndexOutOfRange)
+		}
+		lead = lead.Next
+	}
+
+	trail := l.Head
+	for lead != nil {
+		lead = lead.Next
+		trail = trail.Next
+	}
+	return trail, nil
+}
+
+// Validate checks that the list is well formed: it has no cycle and Length matches the node count
+// This is useful after building or modifying a list through the exported Head and Next fields
+// Time Complexity: O(n), no extra allocations unless an error is returned
+// Returns:
+//   - error: ErrCycle or ErrLengthMismatch (wrapped with details), or nil if the list is valid
+func (l *LinkedList[T]) Validate() error {
+	if start := l.CycleStart(); start != nil {
+		return fmt.Errorf("cycle starting at node %v(%p): %w", start.Data, start, ErrCycle)
+	}
 
-	// Remove the node by updating the Next pointer to skip it
-	previousToDelete.Next = previousToDelete.Next.Next
-	l.Length-- // Decrement the length
+	count := 0
+	for current := l.Head; current != nil; current = current.Next {
+		count++
+	}
+	if count != l.Length {
+		return fmt.Errorf("length %d, counted %d nodes: %w", l.Length, count, ErrLengthMismatch)
+	}
+	return nil
+}
+
+// Sort sorts the list in place using a stable bottom-up merge sort
+// Nodes are relinked rather than copied, and only a constant number of extra pointers is used:
+// each pass merges neighbouring runs of width 1, 2, 4, ... until a single run remains
+// Time Complexity: O(n log n), O(1) extra space
+// Parameters:
+//   - less: Reports whether a must sort before b
+func (l *LinkedList[T]) Sort(less func(a, b T) bool) {
+	if l.Head == nil || l.Head.Next == nil {
+		return // Lists with zero or one node are already sorted
+	}
+
+	for width := 1; ; width *= 2 {
+		var head, tail *Node[T]
+		p := l.Head
+		merges := 0
+
+		for p != nil {
+			merges++
+
+			// Step q past the left run of up to width nodes
+			q := p
+			pSize := 0
+			for pSize < width && q != nil {
+				pSize++
+				q = q.Next
+			}
+			qSize := width
+
+			// Merge the left run starting at p with the right run starting at q
+			for pSize > 0 || (qSize > 0 && q != nil) {
+				var next *Node[T]
+				switch {
+				case pSize == 0:
+					next, q = q, q.Next
+					qSize--
+				case qSize == 0 || q == nil || !less(q.Data, p.Data):
+					// Taking from the left run on ties keeps the sort stable
+					next, p = p, p.Next
+					pSize--
+				default:
+					next, q = q, q.Next
+					qSize--
+				}
+
+				if tail == nil {
+					head = next
+				} else {
+					tail.Next = next
+				}
+				tail = next
+			}
+			p = q
+		}
+
+		tail.Next = nil
+		l.Head, l.tail = head, tail
+		if merges <= 1 {
+			return // A single merge means the whole list was one run
+		}
+	}
+}
+
+// MergeSorted merges another sorted list into this sorted list, leaving other empty
+// Both lists must already be sorted by less; the merge is stable, so on ties nodes from
+// this list come before nodes from other
+// Time Complexity: O(n + m), O(1) extra space
+// Parameters:
+//   - other: The list whose nodes are moved into this list
+//   - less: Reports whether a must sort before b
+func (l *LinkedList[T]) MergeSorted(other *LinkedList[T], less func(a, b T) bool) {
+	if other == l || other.Head == nil {
+		return
+	}
+
+	var head, tail *Node[T]
+	a, b := l.Head, other.Head
+	for a != nil || b != nil {
+		var next *Node[T]
+		if b == nil || (a != nil && !less(b.Data, a.Data)) {
+			next, a = a, a.Next
+		} else {
+			next, b = b, b.Next
+		}
+
+		if tail == nil {
+			head = next
+		} else {
+			tail.Next = next
+		}
+		tail = next
+	}
+
+	l.Head, l.tail = head, tail
+	l.Length += other.Length
+	other.Head, other.tail, other.Length = nil, nil, 0
+}
+
+// Dedup removes consecutive duplicate values from the list, keeping the first node of each run
+// On a sorted list this leaves every value exactly once
+// Time Complexity: O(n), O(1) extra space
+// Returns:
+//   - int: The number of nodes removed
+func Dedup[T comparable](l *LinkedList[T]) int {
+	return l.DedupFunc(func(a, b T) bool { return a == b })
+}
+
+// DedupFunc removes consecutive nodes whose data equal the data of the node before them by eq,
+// keeping the first node of each run
+// Time Complexity: O(n), O(1) extra space
+// Returns:
+//   - int: The number of nodes removed
+func (l *LinkedList[T]) DedupFunc(eq func(a, b T) bool) int {
+	removed := 0
+	for current := l.Head; current != nil; current = current.Next {
+		for current.Next != nil && eq(current.Data, current.Next.Data) {
+			current.Next = current.Next.Next
+			removed++
+		}
+		l.tail = current
+	}
+	l.Length -= removed
+	return removed
+}
+
+// Partition reorders the list so that every node satisfying pred comes before every node that does not
+// The relative order within each group is preserved
+// Time Complexity: O(n), O(1) extra space
+// Parameters:
+//   - pred: Reports whether a value belongs to the front group
+//
+// Returns:
+//   - int: The number of nodes in the front group, i.e. the index of the first node not satisfying pred
+func (l *LinkedList[T]) Partition(pred func(T) bool) int {
+	var matchHead, matchTail, restHead, restTail *Node[T]
+	matched := 0
+
+	for current := l.Head; current != nil; current = current.Next {
+		if pred(current.Data) {
+			if matchTail == nil {
+				matchHead = current
+			} else {
+				matchTail.Next = current
+			}
+			matchTail = current
+			matched++
+		} else {
+			if restTail == nil {
+				restHead = current
+			} else {
+				restTail.Next = current
+			}
+			restTail = current
+		}
+	}
+
+	// Join the two chains and terminate the list
+	if restTail != nil {
+		restTail.Next = nil
+		l.tail = restTail
+	} else {
+		l.tail = matchTail
+	}
+	if matchTail != nil {
+		matchTail.Next = restHead
+		l.Head = matchHead
+	} else {
+		l.Head = restHead
+	}
+	return matched
 }
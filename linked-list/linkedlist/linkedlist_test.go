@@ -1,6 +1,14 @@
 package linkedlist
 
 import (
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"os"
+	"slices"
+	"strings"
 	"testing"
 )
 
@@ -8,7 +16,7 @@ import (
 func TestNewLinkedList(t *testing.T) {
 	tests := []struct {
 		name         string
-		expectedHead *Node
+		expectedHead *Node[int]
 		expectedLen  int
 	}{
 		{
@@ -20,7 +28,7 @@ func TestNewLinkedList(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			list := LinkedList{}
+			list := LinkedList[int]{}
 
 			if list.Head != tt.expectedHead {
 				t.Errorf("New list head = %v, want %v", list.Head, tt.expectedHead)
@@ -63,11 +71,11 @@ func TestPrepend(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			list := LinkedList{}
+			list := LinkedList[int]{}
 
 			// Perform the operations
 			for _, val := range tt.operations {
-				list.Prepend(&Node{Data: val})
+				list.Prepend(&Node[int]{Data: val})
 			}
 
 			// Check the length
@@ -152,15 +160,15 @@ func TestDeleteWithValue(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			list := LinkedList{}
+			list := LinkedList[int]{}
 
 			// Build the initial list
 			for _, val := range tt.initialValues {
-				list.Prepend(&Node{Data: val})
+				list.Prepend(&Node[int]{Data: val})
 			}
 
 			// Perform the deletion
-			list.DeleteWithValue(tt.deleteValue)
+			DeleteWithValue(&list, tt.deleteValue)
 
 			// Check the length
 			if list.Length != tt.wantLen {
@@ -228,15 +236,15 @@ func TestMultipleOperations(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			list := LinkedList{}
+			list := LinkedList[int]{}
 
 			// Build the initial list
 			for _, val := range tt.initialValues {
-				list.Prepend(&Node{Data: val})
+				list.Prepend(&Node[int]{Data: val})
 			}
 
 			// Delete the specified value
-			list.DeleteWithValue(tt.deleteValue)
+			DeleteWithValue(&list, tt.deleteValue)
 
 			// Check the length
 			if list.Length != tt.wantLen {
@@ -304,15 +312,15 @@ func TestDuplicateValues(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			list := LinkedList{}
+			list := LinkedList[int]{}
 
 			// Build the initial list
 			for _, val := range tt.initialValues {
-				list.Prepend(&Node{Data: val})
+				list.Prepend(&Node[int]{Data: val})
 			}
 
 			// Delete the value
-			list.DeleteWithValue(tt.deleteValue)
+			DeleteWithValue(&list, tt.deleteValue)
 
 			// Check the length
 			if list.Length != tt.wantLen {
@@ -399,15 +407,15 @@ func TestEmptyListEdgeCases(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			list := LinkedList{}
+			list := LinkedList[int]{}
 
 			// Perform operations
 			for _, op := range tt.operations {
 				switch op.op {
 				case "add":
-					list.Prepend(&Node{Data: op.value})
+					list.Prepend(&Node[int]{Data: op.value})
 				case "delete":
-					list.DeleteWithValue(op.value)
+					DeleteWithValue(&list, op.value)
 				}
 			}
 
@@ -426,3 +434,975 @@ func TestEmptyListEdgeCases(t *testing.T) {
 		})
 	}
 }
+
+// TestGenericElementTypes tests that the list works with non-int element types
+func TestGenericElementTypes(t *testing.T) {
+	type request struct {
+		ID   int
+		Path string
+	}
+
+	tests := []struct {
+		name       string
+		initial    []request // Values to prepend in sequence
+		deleteID   int       // ID passed to DeleteFunc
+		wantLen    int       // Expected length after deletion
+		wantValues []request // Expected values after deletion
+	}{
+		{
+			name:       "Delete struct by ID",
+			initial:    []request{{1, "/a"}, {2, "/b"}, {3, "/c"}}, // This will create 3->2->1
+			deleteID:   2,
+			wantLen:    2,
+			wantValues: []request{{3, "/c"}, {1, "/a"}},
+		},
+		{
+			name:       "Delete missing ID",
+			initial:    []request{{1, "/a"}},
+			deleteID:   5,
+			wantLen:    1,
+			wantValues: []request{{1, "/a"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := LinkedList[request]{}
+			for _, val := range tt.initial {
+				list.Prepend(&Node[request]{Data: val})
+			}
+
+			list.DeleteFunc(func(r request) bool { return r.ID == tt.deleteID })
+
+			if list.Length != tt.wantLen {
+				t.Errorf("Length after deletion = %d, want %d", list.Length, tt.wantLen)
+			}
+
+			current := list.Head
+			for i, expected := range tt.wantValues {
+				if current == nil {
+					t.Errorf("List ended prematurely at position %d", i)
+					break
+				}
+				if current.Data != expected {
+					t.Errorf("Node at position %d = %v, want %v", i, current.Data, expected)
+				}
+				current = current.Next
+			}
+		})
+	}
+
+	t.Run("Strings with DeleteWithValue", func(t *testing.T) {
+		list := LinkedList[string]{}
+		list.Prepend(&Node[string]{Data: "b"})
+		list.Prepend(&Node[string]{Data: "a"})
+		DeleteWithValue(&list, "a")
+
+		if list.Length != 1 || list.Head.Data != "b" {
+			t.Errorf("List after deletion = (len %d, head %q), want (len 1, head %q)", list.Length, list.Head.Data, "b")
+		}
+	})
+
+	t.Run("Int aliases", func(t *testing.T) {
+		list := IntLinkedList{}
+		list.Prepend(&IntNode{Data: 7})
+
+		if list.Length != 1 || list.Head.Data != 7 {
+			t.Errorf("List = (len %d, head %d), want (len 1, head 7)", list.Length, list.Head.Data)
+		}
+	})
+}
+
+// buildList appends the given values in order and returns the list
+func buildList(values []int) *LinkedList[int] {
+	list := &LinkedList[int]{}
+	for _, val := range values {
+		list.Append(&Node[int]{Data: val})
+	}
+	return list
+}
+
+// checkList verifies the length, node order and tail of a list against the expected values
+func checkList(t *testing.T, list *LinkedList[int], want []int) {
+	t.Helper()
+
+	if list.Length != len(want) {
+		t.Errorf("List length = %d, want %d", list.Length, len(want))
+	}
+
+	current := list.Head
+	for i, expected := range want {
+		if current == nil {
+			t.Errorf("List ended prematurely at position %d", i)
+			return
+		}
+		if current.Data != expected {
+			t.Errorf("Node at position %d = %d, want %d", i, current.Data, expected)
+		}
+		current = current.Next
+	}
+
+	if current != nil {
+		t.Errorf("List has extra nodes beyond expected length")
+	}
+
+	// Appending after the operation must land at the end of the list
+	list.Append(&Node[int]{Data: -1})
+	if got, _ := list.Get(list.Length - 1); got != -1 {
+		t.Errorf("Append after operation did not reach the tail")
+	}
+}
+
+// TestAppend tests adding nodes to the end of the list
+func TestAppend(t *testing.T) {
+	tests := []struct {
+		name      string
+		prepend   []int // Values to prepend first
+		appended  []int // Values to append afterwards
+		wantOrder []int // Expected order of values in list
+	}{
+		{
+			name:      "Append to empty list",
+			appended:  []int{10},
+			wantOrder: []int{10},
+		},
+		{
+			name:      "Multiple appends",
+			appended:  []int{10, 20, 30},
+			wantOrder: []int{10, 20, 30},
+		},
+		{
+			name:      "Append after prepend",
+			prepend:   []int{10, 20},
+			appended:  []int{30},
+			wantOrder: []int{20, 10, 30},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := &LinkedList[int]{}
+			for _, val := range tt.prepend {
+				list.Prepend(&Node[int]{Data: val})
+			}
+			for _, val := range tt.appended {
+				list.Append(&Node[int]{Data: val})
+			}
+
+			checkList(t, list, tt.wantOrder)
+		})
+	}
+}
+
+// TestInsertAt tests inserting nodes at specific positions
+func TestInsertAt(t *testing.T) {
+	tests := []struct {
+		name      string
+		initial   []int // Initial values in order
+		index     int   // Position to insert at
+		value     int   // Value to insert
+		wantErr   bool  // Whether an out-of-range error is expected
+		wantOrder []int // Expected order of values after insertion
+	}{
+		{
+			name:      "Insert into empty list",
+			initial:   []int{},
+			index:     0,
+			value:     10,
+			wantOrder: []int{10},
+		},
+		{
+			name:      "Insert at head",
+			initial:   []int{20, 30},
+			index:     0,
+			value:     10,
+			wantOrder: []int{10, 20, 30},
+		},
+		{
+			name:      "Insert in middle",
+			initial:   []int{10, 30},
+			index:     1,
+			value:     20,
+			wantOrder: []int{10, 20, 30},
+		},
+		{
+			name:      "Insert at end",
+			initial:   []int{10, 20},
+			index:     2,
+			value:     30,
+			wantOrder: []int{10, 20, 30},
+		},
+		{
+			name:      "Index past end",
+			initial:   []int{10},
+			index:     2,
+			value:     30,
+			wantErr:   true,
+			wantOrder: []int{10},
+		},
+		{
+			name:      "Negative index",
+			initial:   []int{10},
+			index:     -1,
+			value:     30,
+			wantErr:   true,
+			wantOrder: []int{10},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := buildList(tt.initial)
+
+			err := list.InsertAt(tt.index, &Node[int]{Data: tt.value})
+			if tt.wantErr != errors.Is(err, ErrIndexOutOfRange) {
+				t.Errorf("InsertAt(%d) error = %v, wantErr %v", tt.index, err, tt.wantErr)
+			}
+
+			checkList(t, list, tt.wantOrder)
+		})
+	}
+}
+
+// TestRemoveAt tests removing nodes at specific positions
+func TestRemoveAt(t *testing.T) {
+	tests := []struct {
+		name      string
+		initial   []int // Initial values in order
+		index     int   // Position to remove
+		wantValue int   // Expected removed value
+		wantErr   bool  // Whether an out-of-range error is expected
+		wantOrder []int // Expected order of values after removal
+	}{
+		{
+			name:      "Remove from empty list",
+			initial:   []int{},
+			index:     0,
+			wantErr:   true,
+			wantOrder: []int{},
+		},
+		{
+			name:      "Remove only node",
+			initial:   []int{10},
+			index:     0,
+			wantValue: 10,
+			wantOrder: []int{},
+		},
+		{
+			name:      "Remove head",
+			initial:   []int{10, 20, 30},
+			index:     0,
+			wantValue: 10,
+			wantOrder: []int{20, 30},
+		},
+		{
+			name:      "Remove middle",
+			initial:   []int{10, 20, 30},
+			index:     1,
+			wantValue: 20,
+			wantOrder: []int{10, 30},
+		},
+		{
+			name:      "Remove tail",
+			initial:   []int{10, 20, 30},
+			index:     2,
+			wantValue: 30,
+			wantOrder: []int{10, 20},
+		},
+		{
+			name:      "Index equal to length",
+			initial:   []int{10, 20},
+			index:     2,
+			wantErr:   true,
+			wantOrder: []int{10, 20},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := buildList(tt.initial)
+
+			got, err := list.RemoveAt(tt.index)
+			if tt.wantErr != errors.Is(err, ErrIndexOutOfRange) {
+				t.Errorf("RemoveAt(%d) error = %v, wantErr %v", tt.index, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.wantValue {
+				t.Errorf("RemoveAt(%d) = %d, want %d", tt.index, got, tt.wantValue)
+			}
+
+			checkList(t, list, tt.wantOrder)
+		})
+	}
+}
+
+// TestGetSet tests reading and replacing values by index
+func TestGetSet(t *testing.T) {
+	tests := []struct {
+		name      string
+		initial   []int // Initial values in order
+		index     int   // Position to read and write
+		wantValue int   // Expected value from Get before Set
+		wantErr   bool  // Whether an out-of-range error is expected
+	}{
+		{name: "First element", initial: []int{10, 20, 30}, index: 0, wantValue: 10},
+		{name: "Last element", initial: []int{10, 20, 30}, index: 2, wantValue: 30},
+		{name: "Empty list", initial: []int{}, index: 0, wantErr: true},
+		{name: "Negative index", initial: []int{10}, index: -1, wantErr: true},
+		{name: "Index equal to length", initial: []int{10}, index: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := buildList(tt.initial)
+
+			got, err := list.Get(tt.index)
+			if tt.wantErr != errors.Is(err, ErrIndexOutOfRange) {
+				t.Fatalf("Get(%d) error = %v, wantErr %v", tt.index, err, tt.wantErr)
+			}
+			if got != tt.wantValue {
+				t.Errorf("Get(%d) = %d, want %d", tt.index, got, tt.wantValue)
+			}
+
+			err = list.Set(tt.index, 99)
+			if tt.wantErr != errors.Is(err, ErrIndexOutOfRange) {
+				t.Fatalf("Set(%d) error = %v, wantErr %v", tt.index, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got, _ := list.Get(tt.index); got != 99 {
+				t.Errorf("Get(%d) after Set = %d, want 99", tt.index, got)
+			}
+			if list.Length != len(tt.initial) {
+				t.Errorf("List length after Set = %d, want %d", list.Length, len(tt.initial))
+			}
+		})
+	}
+}
+
+// TestIndexOfContains tests searching for values
+func TestIndexOfContains(t *testing.T) {
+	tests := []struct {
+		name    string
+		initial []int // Initial values in order
+		value   int   // Value to search for
+		want    int   // Expected index, -1 if absent
+	}{
+		{name: "Empty list", initial: []int{}, value: 10, want: -1},
+		{name: "Found at head", initial: []int{10, 20}, value: 10, want: 0},
+		{name: "Found at tail", initial: []int{10, 20}, value: 20, want: 1},
+		{name: "First of duplicates", initial: []int{10, 20, 20}, value: 20, want: 1},
+		{name: "Not found", initial: []int{10, 20}, value: 30, want: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := buildList(tt.initial)
+
+			if got := IndexOf(list, tt.value); got != tt.want {
+				t.Errorf("IndexOf(%d) = %d, want %d", tt.value, got, tt.want)
+			}
+			if got := Contains(list, tt.value); got != (tt.want != -1) {
+				t.Errorf("Contains(%d) = %v, want %v", tt.value, got, tt.want != -1)
+			}
+		})
+	}
+}
+
+// TestDeleteAll tests removing every occurrence of a value
+func TestDeleteAll(t *testing.T) {
+	tests := []struct {
+		name        string
+		initial     []int // Initial values in order
+		value       int   // Value to delete
+		wantRemoved int   // Expected number of removed nodes
+		wantOrder   []int // Expected order of values after deletion
+	}{
+		{name: "Empty list", initial: []int{}, value: 10, wantRemoved: 0, wantOrder: []int{}},
+		{name: "All nodes match", initial: []int{10, 10, 10}, value: 10, wantRemoved: 3, wantOrder: []int{}},
+		{name: "Leading and trailing matches", initial: []int{10, 20, 10, 30, 10}, value: 10, wantRemoved: 3, wantOrder: []int{20, 30}},
+		{name: "Consecutive middle matches", initial: []int{20, 10, 10, 30}, value: 10, wantRemoved: 2, wantOrder: []int{20, 30}},
+		{name: "No matches", initial: []int{20, 30}, value: 10, wantRemoved: 0, wantOrder: []int{20, 30}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := buildList(tt.initial)
+
+			if got := DeleteAll(list, tt.value); got != tt.wantRemoved {
+				t.Errorf("DeleteAll(%d) = %d, want %d", tt.value, got, tt.wantRemoved)
+			}
+
+			checkList(t, list, tt.wantOrder)
+		})
+	}
+}
+
+// TestFuncVariants tests the Func variants on an element type that is not comparable
+func TestFuncVariants(t *testing.T) {
+	list := LinkedList[[]int]{}
+	for _, val := range [][]int{{3}, {1, 2}, {1, 2}, {}, {1, 2}} {
+		list.Append(&Node[[]int]{Data: val})
+	}
+	isPair := func(s []int) bool { return len(s) == 2 }
+
+	if got := list.IndexFunc(isPair); got != 1 {
+		t.Errorf("IndexFunc = %d, want 1", got)
+	}
+	if list.ContainsFunc(func(s []int) bool { return len(s) == 3 }) {
+		t.Errorf("ContainsFunc reported a slice of length 3")
+	}
+	if got := list.DedupFunc(slices.Equal[[]int]); got != 1 || list.Length != 4 {
+		t.Errorf("DedupFunc = %d with length %d, want 1 with length 4", got, list.Length)
+	}
+	if got := list.DeleteAllFunc(isPair); got != 2 || list.Length != 2 {
+		t.Errorf("DeleteAllFunc = %d with length %d, want 2 with length 2", got, list.Length)
+	}
+	if len(list.Head.Data) != 1 || len(list.tail.Data) != 0 || list.Head.Next != list.tail {
+		t.Errorf("List after DeleteAllFunc = %v, want [[3] []]", list)
+	}
+}
+
+// TestAll tests iterating over the list values and index/value pairs
+func TestAll(t *testing.T) {
+	tests := []struct {
+		name    string
+		initial []int // Initial values in order
+		stop    int   // Number of values to take before breaking, 0 for all
+		want    []int // Expected values produced by the iterator
+	}{
+		{name: "Empty list", initial: []int{}, want: nil},
+		{name: "Full iteration", initial: []int{10, 20, 30}, want: []int{10, 20, 30}},
+		{name: "Early break", initial: []int{10, 20, 30}, stop: 2, want: []int{10, 20}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := buildList(tt.initial)
+
+			var got []int
+			for v := range list.All() {
+				got = append(got, v)
+				if len(got) == tt.stop {
+					break
+				}
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("All() = %v, want %v", got, tt.want)
+			}
+
+			var gotIndexed []int
+			for i, v := range list.Indexed() {
+				if v != tt.initial[i] {
+					t.Errorf("Indexed() at %d = %d, want %d", i, v, tt.initial[i])
+				}
+				gotIndexed = append(gotIndexed, v)
+				if len(gotIndexed) == tt.stop {
+					break
+				}
+			}
+			if !slices.Equal(gotIndexed, tt.want) {
+				t.Errorf("Indexed() values = %v, want %v", gotIndexed, tt.want)
+			}
+		})
+	}
+}
+
+// TestFormatting tests String, WriteTo and the fmt verbs supported by Format
+func TestFormatting(t *testing.T) {
+	tests := []struct {
+		name    string
+		initial []int  // Initial values in order
+		want    string // Expected compact representation
+	}{
+		{name: "Empty list", initial: []int{}, want: "[]"},
+		{name: "Single node", initial: []int{10}, want: "[10]"},
+		{name: "Several nodes", initial: []int{10, 20, 30}, want: "[10 20 30]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := buildList(tt.initial)
+
+			if got := list.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+
+			var sb strings.Builder
+			n, err := list.WriteTo(&sb)
+			if err != nil || sb.String() != tt.want || n != int64(len(tt.want)) {
+				t.Errorf("WriteTo() = (%d, %v) writing %q, want (%d, nil) writing %q", n, err, sb.String(), len(tt.want), tt.want)
+			}
+
+			for _, verb := range []string{"%v", "%s"} {
+				if got := fmt.Sprintf(verb, *list); got != tt.want {
+					t.Errorf("Sprintf(%q) = %q, want %q", verb, got, tt.want)
+				}
+			}
+
+			// The verbose form lists the length and every node with its address
+			var nodes []string
+			for current := list.Head; current != nil; current = current.Next {
+				nodes = append(nodes, fmt.Sprintf("%d(%p)", current.Data, current))
+			}
+			wantVerbose := fmt.Sprintf("LinkedList{length: %d, nodes: [%s]}", len(tt.initial), strings.Join(nodes, " "))
+			if got := fmt.Sprintf("%+v", list); got != wantVerbose {
+				t.Errorf("Sprintf(%%+v) = %q, want %q", got, wantVerbose)
+			}
+		})
+	}
+
+	t.Run("String elements", func(t *testing.T) {
+		list := LinkedList[string]{}
+		list.Append(&Node[string]{Data: "a"})
+		list.Append(&Node[string]{Data: "b"})
+
+		if got := fmt.Sprint(list); got != "[a b]" {
+			t.Errorf("Sprint() = %q, want %q", got, "[a b]")
+		}
+	})
+
+	t.Run("Other verbs format every value", func(t *testing.T) {
+		list := buildList([]int{10, 20})
+
+		for verb, want := range map[string]string{
+			"%d":   "[10 20]",
+			"%x":   "[a 14]",
+			"%03d": "[010 020]",
+			"%t":   "[%!t(int=10) %!t(int=20)]",
+		} {
+			if got := fmt.Sprintf(verb, *list); got != want {
+				t.Errorf("Sprintf(%q) = %q, want %q", verb, got, want)
+			}
+		}
+	})
+}
+
+// TestPrintListData tests printing to stdout, including a Length that overstates the chain
+func TestPrintListData(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	list := buildList([]int{10, 20})
+	list.PrintListData()
+	list.Length = 5
+	list.PrintListData()
+	LinkedList[int]{}.PrintListData()
+
+	w.Close()
+	os.Stdout = stdout
+	out, err := io.ReadAll(r)
+	if want := "[10 20]\n[10 20]\n[]\n"; err != nil || string(out) != want {
+		t.Errorf("PrintListData printed %q (err %v), want %q", out, err, want)
+	}
+}
+
+// buildCyclicList builds a list from values whose last node links back to the node at cycleAt
+// A negative cycleAt leaves the list acyclic
+func buildCyclicList(values []int, cycleAt int) *LinkedList[int] {
+	list := buildList(values)
+	if cycleAt >= 0 {
+		last, _ := list.NthFromEnd(1)
+		last.Next = list.nodeAt(cycleAt)
+	}
+	return list
+}
+
+// TestCycleDetection tests HasCycle and CycleStart on acyclic and cyclic lists
+func TestCycleDetection(t *testing.T) {
+	tests := []struct {
+		name      string
+		values    []int // Values in order
+		cycleAt   int   // Index the last node links back to, -1 for no cycle
+		wantCycle bool  // Expected result of HasCycle
+	}{
+		{name: "Empty list", values: []int{}, cycleAt: -1, wantCycle: false},
+		{name: "Single node", values: []int{10}, cycleAt: -1, wantCycle: false},
+		{name: "Acyclic list", values: []int{10, 20, 30}, cycleAt: -1, wantCycle: false},
+		{name: "Self loop", values: []int{10}, cycleAt: 0, wantCycle: true},
+		{name: "Cycle to head", values: []int{10, 20, 30, 40}, cycleAt: 0, wantCycle: true},
+		{name: "Cycle to middle", values: []int{10, 20, 30, 40, 50}, cycleAt: 2, wantCycle: true},
+		{name: "Cycle to tail", values: []int{10, 20, 30}, cycleAt: 2, wantCycle: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := buildCyclicList(tt.values, tt.cycleAt)
+			var wantStart *Node[int]
+			if tt.cycleAt >= 0 {
+				wantStart = list.nodeAt(tt.cycleAt)
+			}
+
+			if got := list.HasCycle(); got != tt.wantCycle {
+				t.Errorf("HasCycle() = %v, want %v", got, tt.wantCycle)
+			}
+			if got := list.CycleStart(); got != wantStart {
+				t.Errorf("CycleStart() = %p, want %p", got, wantStart)
+			}
+		})
+	}
+}
+
+// TestReverse tests reversing the list in place
+func TestReverse(t *testing.T) {
+	tests := []struct {
+		name      string
+		initial   []int // Initial values in order
+		wantOrder []int // Expected order after reversal
+	}{
+		{name: "Empty list", initial: []int{}, wantOrder: []int{}},
+		{name: "Single node", initial: []int{10}, wantOrder: []int{10}},
+		{name: "Two nodes", initial: []int{10, 20}, wantOrder: []int{20, 10}},
+		{name: "Several nodes", initial: []int{10, 20, 30, 40}, wantOrder: []int{40, 30, 20, 10}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := buildList(tt.initial)
+
+			list.Reverse()
+
+			checkList(t, list, tt.wantOrder)
+		})
+	}
+}
+
+// TestMiddle tests finding the middle node
+func TestMiddle(t *testing.T) {
+	tests := []struct {
+		name    string
+		initial []int // Initial values in order
+		want    int   // Expected middle value
+		wantNil bool  // Whether no node is expected
+	}{
+		{name: "Empty list", initial: []int{}, wantNil: true},
+		{name: "Single node", initial: []int{10}, want: 10},
+		{name: "Odd length", initial: []int{10, 20, 30}, want: 20},
+		{name: "Even length returns second middle", initial: []int{10, 20, 30, 40}, want: 30},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := buildList(tt.initial)
+
+			got := list.Middle()
+			if tt.wantNil {
+				if got != nil {
+					t.Errorf("Middle() = %d, want nil", got.Data)
+				}
+				return
+			}
+			if got == nil || got.Data != tt.want {
+				t.Errorf("Middle() = %v, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestNthFromEnd tests finding nodes counted from the end of the list
+func TestNthFromEnd(t *testing.T) {
+	tests := []struct {
+		name    string
+		initial []int // Initial values in order
+		n       int   // Position from the end, 1 is the last node
+		want    int   // Expected value
+		wantErr bool  // Whether an out-of-range error is expected
+	}{
+		{name: "Last node", initial: []int{10, 20, 30}, n: 1, want: 30},
+		{name: "Second from end", initial: []int{10, 20, 30}, n: 2, want: 20},
+		{name: "First node", initial: []int{10, 20, 30}, n: 3, want: 10},
+		{name: "Past the head", initial: []int{10, 20, 30}, n: 4, wantErr: true},
+		{name: "Zero", initial: []int{10}, n: 0, wantErr: true},
+		{name: "Empty list", initial: []int{}, n: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := buildList(tt.initial)
+
+			got, err := list.NthFromEnd(tt.n)
+			if tt.wantErr != errors.Is(err, ErrIndexOutOfRange) {
+				t.Fatalf("NthFromEnd(%d) error = %v, wantErr %v", tt.n, err, tt.wantErr)
+			}
+			if !tt.wantErr && got.Data != tt.want {
+				t.Errorf("NthFromEnd(%d) = %d, want %d", tt.n, got.Data, tt.want)
+			}
+		})
+	}
+}
+
+// TestValidate tests detecting malformed lists
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		build   func() *LinkedList[int] // Builds the list under test
+		wantErr error                   // Expected sentinel error, nil if valid
+	}{
+		{
+			name:    "Empty list",
+			build:   func() *LinkedList[int] { return &LinkedList[int]{} },
+			wantErr: nil,
+		},
+		{
+			name:    "Well-formed list",
+			build:   func() *LinkedList[int] { return buildList([]int{10, 20, 30}) },
+			wantErr: nil,
+		},
+		{
+			name:    "Cyclic list",
+			build:   func() *LinkedList[int] { return buildCyclicList([]int{10, 20, 30}, 1) },
+			wantErr: ErrCycle,
+		},
+		{
+			name: "Length too large",
+			build: func() *LinkedList[int] {
+				list := buildList([]int{10, 20})
+				list.Length = 5
+				return list
+			},
+			wantErr: ErrLengthMismatch,
+		},
+		{
+			name: "Node linked by hand",
+			build: func() *LinkedList[int] {
+				list := buildList([]int{10, 20})
+				list.Head.Next.Next = &Node[int]{Data: 30}
+				return list
+			},
+			wantErr: ErrLengthMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.build().Validate()
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Errorf("Validate() = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestUtilitiesDoNotAllocate tests that the traversal utilities are allocation-free
+func TestUtilitiesDoNotAllocate(t *testing.T) {
+	list := buildList([]int{10, 20, 30, 40, 50})
+
+	allocs := testing.AllocsPerRun(100, func() {
+		list.HasCycle()
+		list.CycleStart()
+		list.Reverse()
+		list.Middle()
+		list.NthFromEnd(2)
+		list.Validate()
+	})
+	if allocs != 0 {
+		t.Errorf("Utilities allocated %.1f times per run, want 0", allocs)
+	}
+}
+
+// lessInt orders ints ascending
+func lessInt(a, b int) bool { return a < b }
+
+// TestSort tests sorting the list in place
+func TestSort(t *testing.T) {
+	tests := []struct {
+		name    string
+		initial []int // Initial values in order
+	}{
+		{name: "Empty list", initial: []int{}},
+		{name: "Single node", initial: []int{10}},
+		{name: "Already sorted", initial: []int{10, 20, 30, 40}},
+		{name: "Reversed", initial: []int{50, 40, 30, 20, 10}},
+		{name: "Duplicates", initial: []int{30, 10, 20, 10, 30, 20}},
+		{name: "Odd length", initial: []int{7, 3, 9, 1, 5, 8, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := buildList(tt.initial)
+			want := slices.Sorted(slices.Values(tt.initial))
+
+			list.Sort(lessInt)
+
+			checkList(t, list, want)
+		})
+	}
+
+	t.Run("Stable", func(t *testing.T) {
+		type pair struct{ key, seq int }
+		list := LinkedList[pair]{}
+		var want []pair
+		for i, key := range []int{3, 1, 2, 1, 3, 2, 1} {
+			list.Append(&Node[pair]{Data: pair{key, i}})
+			want = append(want, pair{key, i})
+		}
+		slices.SortStableFunc(want, func(a, b pair) int { return a.key - b.key })
+
+		list.Sort(func(a, b pair) bool { return a.key < b.key })
+
+		if got := slices.Collect(list.All()); !slices.Equal(got, want) {
+			t.Errorf("Sort() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("Random", func(t *testing.T) {
+		r := rand.New(rand.NewPCG(1, 2))
+		values := make([]int, 1000)
+		for i := range values {
+			values[i] = r.IntN(100)
+		}
+		list := buildList(values)
+
+		list.Sort(lessInt)
+
+		checkList(t, list, slices.Sorted(slices.Values(values)))
+	})
+}
+
+// TestMergeSorted tests merging two sorted lists
+func TestMergeSorted(t *testing.T) {
+	tests := []struct {
+		name      string
+		left      []int // Values of the receiving list
+		right     []int // Values of the list merged in
+		wantOrder []int // Expected order after merging
+	}{
+		{name: "Both empty", left: []int{}, right: []int{}, wantOrder: []int{}},
+		{name: "Empty receiver", left: []int{}, right: []int{10, 20}, wantOrder: []int{10, 20}},
+		{name: "Empty other", left: []int{10, 20}, right: []int{}, wantOrder: []int{10, 20}},
+		{name: "Interleaved", left: []int{10, 30, 50}, right: []int{20, 40, 60}, wantOrder: []int{10, 20, 30, 40, 50, 60}},
+		{name: "Other entirely before", left: []int{40, 50}, right: []int{10, 20}, wantOrder: []int{10, 20, 40, 50}},
+		{name: "Ties", left: []int{10, 20}, right: []int{10, 20}, wantOrder: []int{10, 10, 20, 20}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := buildList(tt.left)
+			other := buildList(tt.right)
+
+			list.MergeSorted(other, lessInt)
+
+			if other.Head != nil || other.Length != 0 {
+				t.Errorf("Other list after merge = (head %v, length %d), want empty", other.Head, other.Length)
+			}
+			checkList(t, list, tt.wantOrder)
+		})
+	}
+}
+
+// TestDedup tests removing consecutive duplicates
+func TestDedup(t *testing.T) {
+	tests := []struct {
+		name        string
+		initial     []int // Initial values in order
+		wantRemoved int   // Expected number of removed nodes
+		wantOrder   []int // Expected order after deduplication
+	}{
+		{name: "Empty list", initial: []int{}, wantRemoved: 0, wantOrder: []int{}},
+		{name: "No duplicates", initial: []int{10, 20, 30}, wantRemoved: 0, wantOrder: []int{10, 20, 30}},
+		{name: "All equal", initial: []int{10, 10, 10}, wantRemoved: 2, wantOrder: []int{10}},
+		{name: "Sorted with runs", initial: []int{10, 10, 20, 30, 30, 30}, wantRemoved: 3, wantOrder: []int{10, 20, 30}},
+		{name: "Unsorted keeps non-adjacent", initial: []int{10, 20, 10}, wantRemoved: 0, wantOrder: []int{10, 20, 10}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := buildList(tt.initial)
+
+			if got := Dedup(list); got != tt.wantRemoved {
+				t.Errorf("Dedup() = %d, want %d", got, tt.wantRemoved)
+			}
+
+			checkList(t, list, tt.wantOrder)
+		})
+	}
+}
+
+// TestPartition tests stable partitioning by a predicate
+func TestPartition(t *testing.T) {
+	isEven := func(v int) bool { return v%2 == 0 }
+
+	tests := []struct {
+		name        string
+		initial     []int // Initial values in order
+		wantMatched int   // Expected number of even values
+		wantOrder   []int // Expected order after partitioning
+	}{
+		{name: "Empty list", initial: []int{}, wantMatched: 0, wantOrder: []int{}},
+		{name: "All match", initial: []int{2, 4, 6}, wantMatched: 3, wantOrder: []int{2, 4, 6}},
+		{name: "None match", initial: []int{1, 3, 5}, wantMatched: 0, wantOrder: []int{1, 3, 5}},
+		{name: "Mixed", initial: []int{1, 2, 3, 4, 5, 6}, wantMatched: 3, wantOrder: []int{2, 4, 6, 1, 3, 5}},
+		{name: "Matching tail", initial: []int{1, 3, 2}, wantMatched: 1, wantOrder: []int{2, 1, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := buildList(tt.initial)
+
+			if got := list.Partition(isEven); got != tt.wantMatched {
+				t.Errorf("Partition() = %d, want %d", got, tt.wantMatched)
+			}
+
+			checkList(t, list, tt.wantOrder)
+		})
+	}
+}
+
+// benchmarkValues returns n pseudo-random ints so every benchmark sorts the same input
+func benchmarkValues(n int) []int {
+	r := rand.New(rand.NewPCG(1, 2))
+	values := make([]int, n)
+	for i := range values {
+		values[i] = r.Int()
+	}
+	return values
+}
+
+// resetValues writes values back into the nodes of list in chain order, undoing a sort
+// without allocating; the benchmarks below include this O(n) pass in every iteration
+func resetValues(list *LinkedList[int], values []int) {
+	i := 0
+	for current := list.Head; current != nil; current = current.Next {
+		current.Data = values[i]
+		i++
+	}
+}
+
+// BenchmarkSort measures the in-place merge sort
+func BenchmarkSort(b *testing.B) {
+	for _, n := range []int{100, 10_000, 1_000_000} {
+		values := benchmarkValues(n)
+		list := buildList(values)
+		b.Run(fmt.Sprintf("n=%d", n), func(b *testing.B) {
+			for b.Loop() {
+				resetValues(list, values)
+				list.Sort(lessInt)
+			}
+		})
+	}
+}
+
+// BenchmarkSortViaSlice measures the slice round-trip that Sort replaces:
+// copy the values out, sort the slice, then rebuild the list
+func BenchmarkSortViaSlice(b *testing.B) {
+	for _, n := range []int{100, 10_000, 1_000_000} {
+		values := benchmarkValues(n)
+		list := buildList(values)
+		b.Run(fmt.Sprintf("n=%d", n), func(b *testing.B) {
+			for b.Loop() {
+				resetValues(list, values)
+				sorted := slices.Collect(list.All())
+				slices.SortStableFunc(sorted, cmp.Compare[int])
+				*list = LinkedList[int]{}
+				for _, v := range sorted {
+					list.Append(&Node[int]{Data: v})
+				}
+			}
+		})
+	}
+}
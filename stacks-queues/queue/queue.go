@@ -1,5 +1,12 @@
 package queue
 
+import (
+	"fmt"
+	"io"
+	"iter"
+	"strings"
+)
+
 // Queue represents a queue data structure that follows FIFO (First In First Out) principle
 type Queue struct {
 	items []int
@@ -62,3 +69,54 @@ func (q *Queue) Size() int {
 func (q *Queue) Clear() {
 	q.items = []int{}
 }
+
+// All returns an iterator over the items in the queue, from front to back
+// This is the order in which Dequeue would return them; the queue itself is not modified
+// Time Complexity: O(n) for a full iteration, O(1) per step
+func (q *Queue) All() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for _, item := range q.items {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// String returns the items in the queue from front to back, e.g. "[10 20 30]"
+// This method implements the Stringer interface for better debugging and printing
+// Time Complexity: O(n) - linear time operation
+func (q Queue) String() string {
+	var sb strings.Builder
+	q.WriteTo(&sb)
+	return sb.String()
+}
+
+// WriteTo writes the same representation as String to w
+// This method implements the io.WriterTo interface
+// Returns:
+//   - int64: The number of bytes written
+//   - error: Any error returned by w
+func (q Queue) WriteTo(w io.Writer) (int64, error) {
+	n, err := fmt.Fprint(w, q.items)
+	return int64(n), err
+}
+
+// Format implements the fmt.Formatter interface
+//   - %v and %s print the compact form returned by String
+//   - %+v also prints the size and the front item, e.g. "Queue{size: 3, front: 10, items: [10 20 30]}"
+//   - Any other verb formats each item with that verb and its flags, e.g. %x prints "[a 14]"
+func (q Queue) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('+'):
+		fmt.Fprintf(f, "Queue{size: %d, ", len(q.items))
+		if len(q.items) > 0 {
+			fmt.Fprintf(f, "front: %d, ", q.items[0])
+		}
+		fmt.Fprintf(f, "items: %v}", q.items)
+	case verb == 'v' || verb == 's':
+		q.WriteTo(f)
+	default:
+		fmt.Fprintf(f, fmt.FormatString(f, verb), q.items)
+	}
+}
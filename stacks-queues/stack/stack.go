@@ -1,5 +1,12 @@
 package stack
 
+import (
+	"fmt"
+	"io"
+	"iter"
+	"strings"
+)
+
 // Stack represents a stack data structure that follows LIFO (Last In First Out) principle
 type Stack struct {
 	items []int
@@ -64,3 +71,85 @@ func (s *Stack) Size() int {
 func (s *Stack) Clear() {
 	s.items = []int{}
 }
+
+// All returns an iterator over the items in the stack, from top to bottom
+// This is the order in which Pop would return them and String prints them; the stack itself is
+// not modified
+// Time Complexity: O(n) for a full iteration, O(1) per step
+func (s *Stack) All() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := len(s.items) - 1; i >= 0; i-- {
+			if !yield(s.items[i]) {
+				return
+			}
+		}
+	}
+}
+
+// String returns the items in the stack from top to bottom, e.g. "[30 20 10]" after pushing 10, 20
+// and 30, which is the order All yields them and Pop would return them
+// This method implements the Stringer interface for better debugging and printing
+// Time Complexity: O(n) - linear time operation
+func (s Stack) String() string {
+	var sb strings.Builder
+	s.WriteTo(&sb)
+	return sb.String()
+}
+
+// WriteTo writes the same representation as String to w
+// This method implements the io.WriterTo interface
+// Returns:
+//   - int64: The number of bytes written
+//   - error: Any error returned by w
+func (s Stack) WriteTo(w io.Writer) (int64, error) {
+	return s.writeItems(w, "%v")
+}
+
+// Format implements the fmt.Formatter interface
+// Every form lists the items from top to bottom, like String
+//   - %v and %s print the compact form returned by String
+//   - %+v also prints the size and the top item, e.g. "Stack{size: 3, top: 30, items: [30 20 10]}"
+//   - Any other verb formats each item with that verb and its flags, e.g. %x prints "[1e 14 a]"
+func (s Stack) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('+'):
+		fmt.Fprintf(f, "Stack{size: %d, ", len(s.items))
+		if len(s.items) > 0 {
+			fmt.Fprintf(f, "top: %d, ", s.items[len(s.items)-1])
+		}
+		io.WriteString(f, "items: ")
+		s.writeItems(f, "%v")
+		io.WriteString(f, "}")
+	case verb == 'v' || verb == 's':
+		s.WriteTo(f)
+	default:
+		s.writeItems(f, fmt.FormatString(f, verb))
+	}
+}
+
+// writeItems writes the items from top to bottom as a space-separated list in square brackets,
+// each formatted with format
+func (s Stack) writeItems(w io.Writer, format string) (int64, error) {
+	var total int64
+	write := func(format string, a ...any) error {
+		n, err := fmt.Fprintf(w, format, a...)
+		total += int64(n)
+		return err
+	}
+
+	if err := write("["); err != nil {
+		return total, err
+	}
+	for i := len(s.items) - 1; i >= 0; i-- {
+		if i < len(s.items)-1 {
+			if err := write(" "); err != nil {
+				return total, err
+			}
+		}
+		if err := write(format, s.items[i]); err != nil {
+			return total, err
+		}
+	}
+	err := write("]")
+	return total, err
+}
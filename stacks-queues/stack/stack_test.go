@@ -1,6 +1,9 @@
 package stack
 
 import (
+	"fmt"
+	"slices"
+	"strings"
 	"testing"
 )
 
@@ -205,3 +208,102 @@ func TestClear(t *testing.T) {
 		t.Errorf("Stack size = %d after Clear(), want 0", s.Size())
 	}
 }
+
+// TestAll tests iterating over the stack without modifying it
+func TestAll(t *testing.T) {
+	tests := []struct {
+		name  string
+		items []int // Values to push in sequence
+		stop  int   // Number of items to take before breaking, 0 for all
+		want  []int // Expected items produced by the iterator
+	}{
+		{name: "Empty stack", items: []int{}, want: nil},
+		{name: "Full iteration", items: []int{10, 20, 30}, want: []int{30, 20, 10}},
+		{name: "Early break", items: []int{10, 20, 30}, stop: 1, want: []int{30}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := Stack{}
+			for _, val := range tt.items {
+				s.Push(val)
+			}
+
+			var got []int
+			for v := range s.All() {
+				got = append(got, v)
+				if len(got) == tt.stop {
+					break
+				}
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("All() = %v, want %v", got, tt.want)
+			}
+			if s.Size() != len(tt.items) {
+				t.Errorf("Stack size after iteration = %d, want %d", s.Size(), len(tt.items))
+			}
+		})
+	}
+}
+
+// TestFormatting tests String, WriteTo and the fmt verbs supported by Format
+func TestFormatting(t *testing.T) {
+	tests := []struct {
+		name        string
+		items       []int  // Values to push in sequence
+		wantCompact string // Expected output of String, %v, %s and %d
+		wantVerbose string // Expected output of %+v
+		wantHex     string // Expected output of %x
+	}{
+		{
+			name:        "Empty stack",
+			items:       []int{},
+			wantCompact: "[]",
+			wantVerbose: "Stack{size: 0, items: []}",
+			wantHex:     "[]",
+		},
+		{
+			name:        "Several items",
+			items:       []int{10, 20, 30},
+			wantCompact: "[30 20 10]",
+			wantVerbose: "Stack{size: 3, top: 30, items: [30 20 10]}",
+			wantHex:     "[1e 14 a]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := Stack{}
+			for _, val := range tt.items {
+				s.Push(val)
+			}
+
+			if got := s.String(); got != tt.wantCompact {
+				t.Errorf("String() = %q, want %q", got, tt.wantCompact)
+			}
+			// String lists the items in the same order as All
+			if got, want := s.String(), fmt.Sprint(slices.Collect(s.All())); got != want {
+				t.Errorf("String() = %q, want the order of All %q", got, want)
+			}
+
+			var sb strings.Builder
+			if n, err := s.WriteTo(&sb); err != nil || sb.String() != tt.wantCompact || n != int64(len(tt.wantCompact)) {
+				t.Errorf("WriteTo() = (%d, %v) writing %q, want (%d, nil) writing %q", n, err, sb.String(), len(tt.wantCompact), tt.wantCompact)
+			}
+
+			if got := fmt.Sprintf("%v|%s", s, &s); got != tt.wantCompact+"|"+tt.wantCompact {
+				t.Errorf("Sprintf(%%v|%%s) = %q, want %q", got, tt.wantCompact+"|"+tt.wantCompact)
+			}
+
+			if got := fmt.Sprintf("%+v", s); got != tt.wantVerbose {
+				t.Errorf("Sprintf(%%+v) = %q, want %q", got, tt.wantVerbose)
+			}
+
+			// Other verbs format every item
+			if got := fmt.Sprintf("%d|%x", s, s); got != tt.wantCompact+"|"+tt.wantHex {
+				t.Errorf("Sprintf(%%d|%%x) = %q, want %q", got, tt.wantCompact+"|"+tt.wantHex)
+			}
+		})
+	}
+}
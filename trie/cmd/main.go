@@ -48,4 +48,77 @@ func main() {
 	fmt.Println("\n--- Final State ---")
 	fmt.Println("Number of words after deletion:", myTrie.Count())
 	fmt.Println("All words after deletion:", myTrie.ListWords())
+
+	// Mixed-case UTF-8 words
+	fmt.Println("\n--- Unicode Words ---")
+	handles := trie.InitTrie()
+	for _, handle := range []string{"Zoë-42", "zoe", "日本語", "🚀launch"} {
+		handles.Insert(handle)
+	}
+	fmt.Println("Handles:", handles.ListWords())
+	fmt.Println("Searching for 'Zoë-42':", handles.Search("Zoë-42"))
+	fmt.Println("Searching for 'zoë-42' (case-sensitive):", handles.Search("zoë-42"))
+	fmt.Println("Starts with '日本':", handles.StartsWith("日本"))
+
+	// Case-insensitive words
+	fmt.Println("\n--- Case Folding ---")
+	names := trie.New[struct{}](trie.WithCaseFolding())
+	for _, name := range []string{"GoLang", "golang", "ÉCOLE"} {
+		names.Insert(name)
+	}
+	fmt.Println("Names:", names.ListWords())
+	fmt.Println("Searching for 'GOLANG':", names.Search("GOLANG"))
+	fmt.Println("Searching for 'école':", names.Search("école"))
+
+	// Autocomplete
+	fmt.Println("\n--- Autocomplete ---")
+	queries := trie.New[struct{}](trie.WithTopKCache(3))
+	searches := map[string]float64{"golang": 120, "gopher": 45, "google": 300, "gold": 80, "graph": 60}
+	for query, count := range searches {
+		queries.InsertWeighted(query, count)
+	}
+	queries.Insert("go")
+	fmt.Println("First 3 completions of 'go':", queries.WithPrefix("go", 3))
+	fmt.Println("Top 3 completions of 'go':", queries.TopK("go", 3))
+	queries.InsertWeighted("gopher", 500)
+	fmt.Println("Top 3 after 'gopher' trends:", queries.TopK("go", 3))
+
+	// Routing table
+	fmt.Println("\n--- Prefix Map ---")
+	routes := trie.New[string]()
+	routes.Put("/", "home")
+	routes.Put("/api/", "apiIndex")
+	routes.Put("/api/users/", "users")
+	routes.Put("/static/", "files")
+	handler, _ := routes.Get("/api/")
+	fmt.Println("Handler for '/api/':", handler)
+	for _, path := range []string{"/api/users/42", "/api/orders", "/static/app.js", "/about"} {
+		route, handler, _ := routes.LongestPrefixOf(path)
+		fmt.Printf("Routing '%s' -> %s (matched '%s')\n", path, handler, route)
+	}
+	fmt.Println("Routes under '/api/':")
+	routes.WalkPrefix("/api/", func(route, handler string) bool {
+		fmt.Printf("  %s -> %s\n", route, handler)
+		return true
+	})
+
+	// Spell correction
+	fmt.Println("\n--- Fuzzy Search ---")
+	dictionary := trie.InitTrie()
+	for _, word := range []string{"apple", "apply", "ample", "maple", "banana"} {
+		dictionary.Insert(word)
+	}
+	fmt.Println("Within 2 edits of 'appel':", dictionary.FuzzySearch("appel", 2))
+	fmt.Println("Within 1 edit of 'bananas':", dictionary.FuzzySearch("bananas", 1))
+	fmt.Println("2 suggestions for 'aple':", dictionary.Suggest("aple", 2))
+
+	// Wildcard patterns
+	fmt.Println("\n--- Pattern Matching ---")
+	words := trie.InitTrie()
+	for _, word := range []string{"cat", "cot", "cut", "coat", "reading", "rebooting", "ring", "red"} {
+		words.Insert(word)
+	}
+	for _, pattern := range []string{"c?t", "c*t", "re*ing", "*ing", "r??"} {
+		fmt.Printf("Match '%s': %v\n", pattern, words.Match(pattern))
+	}
 }
@@ -0,0 +1,154 @@
+package trie
+
+import (
+	"cmp"
+	"slices"
+	"unicode/utf8"
+)
+
+// Completion is a word together with its weight, as returned by TopK
+type Completion struct {
+	Word   string
+	Weight float64
+}
+
+// InsertWeighted adds a word to the Trie with the given weight, or sets the weight of a word that
+// is already present. TopK ranks completions by weight, highest first
+// A NaN weight ranks below every other weight
+// Time complexity: O(m log k + m*c) where c is the cache size; a word whose weight goes down
+// while it is among a full cache also rebuilds that node's cache from its children
+func (t *Trie[V]) InsertWeighted(word string, weight float64) {
+	t.insert(t.key(word), weight, true)
+}
+
+// Weight returns the weight of a word
+// Returns:
+//   - float64: The weight, or 0 if the word is absent
+//   - bool: True if the word is in the Trie
+func (t *Trie[V]) Weight(word string) (float64, bool) {
+	if n := t.find(t.key(word)); n != nil && n.isEnd {
+		return n.weight, true
+	}
+	return 0, false
+}
+
+// WithPrefix returns the words that start with the prefix, in lexicographic order
+// Only the subtree below the prefix is visited, and the walk stops as soon as limit words are found
+// Time complexity: O(p log k + s) where s is the size of the part of the subtree that is visited
+// Parameters:
+//   - prefix: The prefix to complete; the empty prefix matches every word
+//   - limit: The maximum number of words to return, or a negative number for no limit
+func (t *Trie[V]) WithPrefix(prefix string, limit int) []string {
+	result := []string{}
+	prefix = t.key(prefix)
+	node := t.find(prefix)
+	if node == nil || limit == 0 {
+		return result
+	}
+	walkWords(node, []byte(prefix), func(word string, _ V) bool {
+		result = append(result, word)
+		return len(result) != limit
+	})
+	return result
+}
+
+// TopK returns the k highest-weighted words that start with the prefix, best first, breaking ties
+// in lexicographic order
+// With WithTopKCache every node caches its best completions, so for k up to the cache size this
+// costs no more than finding the prefix; a larger k, or a Trie without the cache, scans the
+// subtree below the prefix
+// Time complexity: O(p log k + k) from the cache, O(p log k + s log s) otherwise where s is the
+// number of words starting with the prefix
+func (t *Trie[V]) TopK(prefix string, k int) []Completion {
+	prefix = t.key(prefix)
+	node := t.find(prefix)
+	if node == nil || k <= 0 {
+		return []Completion{}
+	}
+
+	// The cache holds every word of the subtree when it is not full
+	if k <= len(node.top) || len(node.top) < t.cacheSize {
+		return slices.Clone(node.top[:min(k, len(node.top))])
+	}
+
+	var all []Completion
+	collectCompletions(node, []byte(prefix), &all)
+	slices.SortFunc(all, compareCompletions)
+	return all[:min(k, len(all))]
+}
+
+// collectCompletions appends every word below node, with its weight, to result
+func collectCompletions[V any](node *Node[V], prefix []byte, result *[]Completion) {
+	if node.isEnd {
+		*result = append(*result, Completion{Word: string(prefix), Weight: node.weight})
+	}
+	for _, c := range node.children {
+		collectCompletions(c.node, utf8.AppendRune(prefix, c.char), result)
+	}
+}
+
+// compareCompletions orders completions best first: by weight, highest first, then by word
+func compareCompletions(a, b Completion) int {
+	return cmp.Or(cmp.Compare(b.Weight, a.Weight), cmp.Compare(a.Word, b.Word))
+}
+
+// updateCaches refreshes the top-k caches on the path to a word whose weight was just set or
+// which was just deleted, bottom-up so every node can rely on its children's caches
+// Parameters:
+//   - path: The nodes from the root towards the word, as far as they still exist
+//   - word: The word, as a key
+//   - dropped: True if the word was deleted or its weight went down; it may then have to make
+//     room in a full cache for a word that was not cached before
+func (t *Trie[V]) updateCaches(path []*Node[V], word string, dropped bool) {
+	if t.cacheSize == 0 {
+		return
+	}
+
+	// ends[i] is the byte length of the prefix of word that leads to path[i]
+	ends := make([]int, 1, len(path))
+	for i, r := range word {
+		ends = append(ends, i+utf8.RuneLen(r))
+	}
+
+	target := path[len(path)-1]
+	for i := len(path) - 1; i >= 0; i-- {
+		node := path[i]
+		cached := slices.IndexFunc(node.top, func(c Completion) bool { return c.Word == word })
+		switch {
+		case dropped && cached >= 0 && len(node.top) == t.cacheSize:
+			// The cache is full, so the next best word may not be cached yet
+			node.rebuild(word[:ends[i]], t.cacheSize)
+		case dropped && cached < 0:
+			// Not among the best before going down, so not among them now
+		default:
+			if cached >= 0 {
+				node.top = slices.Delete(node.top, cached, cached+1)
+			}
+			if target.isEnd && len(word) == ends[len(path)-1] {
+				node.offer(Completion{Word: word, Weight: target.weight}, t.cacheSize)
+			}
+		}
+	}
+}
+
+// offer adds the completion to the node's cache if it ranks among the best size entries
+func (n *Node[V]) offer(c Completion, size int) {
+	i, _ := slices.BinarySearchFunc(n.top, c, compareCompletions)
+	if i < size {
+		n.top = slices.Insert(n.top, i, c)
+		n.top = n.top[:min(len(n.top), size)]
+	}
+}
+
+// rebuild recomputes the node's cache from its own word, spelled by prefix, and its children's caches
+func (n *Node[V]) rebuild(prefix string, size int) {
+	n.top = n.top[:0]
+	if n.isEnd {
+		n.top = append(n.top, Completion{Word: prefix, Weight: n.weight})
+	}
+	for _, c := range n.children {
+		n.top = append(n.top, c.node.top...)
+	}
+	slices.SortFunc(n.top, compareCompletions)
+	n.top = n.top[:min(len(n.top), size)]
+}
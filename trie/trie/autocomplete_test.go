@@ -0,0 +1,273 @@
+package trie
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// checkCaches verifies that every node caches exactly the best completions of its subtree
+func checkCaches[V any](t *testing.T, trie *Trie[V]) {
+	t.Helper()
+
+	var check func(node *Node[V], prefix []byte)
+	check = func(node *Node[V], prefix []byte) {
+		var all []Completion
+		collectCompletions(node, prefix, &all)
+		slices.SortFunc(all, compareCompletions)
+		want := all[:min(len(all), trie.cacheSize)]
+		if !slices.EqualFunc(node.top, want, sameCompletion) {
+			t.Errorf("Cache of %q = %v, want %v", prefix, node.top, want)
+		}
+		for _, c := range node.children {
+			check(c.node, append(slices.Clone(prefix), string(c.char)...))
+		}
+	}
+	check(trie.root, nil)
+}
+
+// sameCompletion reports whether two completions are equal, treating NaN weights as equal
+func sameCompletion(a, b Completion) bool {
+	return compareCompletions(a, b) == 0
+}
+
+// TestWithPrefix tests listing the completions of a prefix
+func TestWithPrefix(t *testing.T) {
+	myTrie := InitTrie()
+	for _, word := range []string{"car", "card", "care", "careful", "cart", "cat", "dog", "ca"} {
+		myTrie.Insert(word)
+	}
+
+	tests := []struct {
+		prefix string
+		limit  int
+		want   []string
+	}{
+		{"car", -1, []string{"car", "card", "care", "careful", "cart"}},
+		{"car", 3, []string{"car", "card", "care"}},
+		{"car", 100, []string{"car", "card", "care", "careful", "cart"}},
+		{"car", 0, []string{}},
+		{"care", -1, []string{"care", "careful"}},
+		{"ca", 2, []string{"ca", "car"}},
+		{"", 2, []string{"ca", "car"}},
+		{"d", -1, []string{"dog"}},
+		{"x", -1, []string{}},
+		{"careless", -1, []string{}},
+	}
+
+	for _, tt := range tests {
+		if got := myTrie.WithPrefix(tt.prefix, tt.limit); !slices.Equal(got, tt.want) {
+			t.Errorf("WithPrefix(%q, %d) = %q, want %q", tt.prefix, tt.limit, got, tt.want)
+		}
+	}
+
+	// The prefix goes through the same case folding as the words
+	folded := New[struct{}](WithCaseFolding())
+	folded.Insert("GoLang")
+	folded.Insert("Gopher")
+	if got := folded.WithPrefix("GO", -1); !slices.Equal(got, []string{"golang", "gopher"}) {
+		t.Errorf("WithPrefix(%q) with case folding = %q, want [golang gopher]", "GO", got)
+	}
+}
+
+// TestTopK tests ranking completions by weight
+func TestTopK(t *testing.T) {
+	myTrie := New[struct{}](WithTopKCache(3))
+	weights := map[string]float64{
+		"car": 50, "card": 20, "care": 80, "careful": 10, "cart": 20, "cat": 90, "dog": 100,
+	}
+	for word, weight := range weights {
+		myTrie.InsertWeighted(word, weight)
+	}
+	myTrie.Insert("carp") // Weight 0
+	checkCaches(t, myTrie)
+
+	tests := []struct {
+		name   string
+		prefix string
+		k      int
+		want   []string
+	}{
+		{"From the cache", "car", 2, []string{"care", "car"}},
+		{"Ties in lexicographic order", "car", 4, []string{"care", "car", "card", "cart"}},
+		{"Beyond the cache size", "car", 10, []string{"care", "car", "card", "cart", "careful", "carp"}},
+		{"Whole trie", "", 3, []string{"dog", "cat", "care"}},
+		{"Single word", "careful", 5, []string{"careful"}},
+		{"Missing prefix", "x", 5, []string{}},
+		{"Zero k", "car", 0, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := myTrie.TopK(tt.prefix, tt.k)
+			words := make([]string, len(got))
+			for i, c := range got {
+				words[i] = c.Word
+				if w, _ := myTrie.Weight(c.Word); c.Weight != w {
+					t.Errorf("TopK reported weight %v for %q, want %v", c.Weight, c.Word, w)
+				}
+			}
+			if !slices.Equal(words, tt.want) {
+				t.Errorf("TopK(%q, %d) = %q, want %q", tt.prefix, tt.k, words, tt.want)
+			}
+		})
+	}
+
+	// Changing a weight reorders the completions
+	myTrie.InsertWeighted("careful", 1000)
+	myTrie.InsertWeighted("care", 1)
+	if got := myTrie.TopK("car", 2); got[0].Word != "careful" || got[1].Word != "car" {
+		t.Errorf("TopK after reweighting = %v, want careful then car", got)
+	}
+	// Insert does not reset the weight of an existing word
+	myTrie.Insert("careful")
+	if w, ok := myTrie.Weight("careful"); !ok || w != 1000 {
+		t.Errorf("Weight(%q) after Insert = (%v, %v), want (1000, true)", "careful", w, ok)
+	}
+	checkCaches(t, myTrie)
+
+	// Deleted words drop out of the rankings
+	myTrie.Delete("careful")
+	if got := myTrie.TopK("car", 1); got[0].Word != "car" {
+		t.Errorf("TopK after deleting the best word = %v, want car", got)
+	}
+	if _, ok := myTrie.Weight("careful"); ok {
+		t.Errorf("Weight(%q) after Delete reported a weight", "careful")
+	}
+	checkCaches(t, myTrie)
+}
+
+// TestTopKNaN tests that a NaN weight ranks below every other weight
+func TestTopKNaN(t *testing.T) {
+	myTrie := New[struct{}](WithTopKCache(2))
+	myTrie.InsertWeighted("a", 5)
+	myTrie.InsertWeighted("b", 4)
+	myTrie.InsertWeighted("c", 3)
+
+	// a leaves the full cache, and c, which was not cached, has to take its place
+	myTrie.InsertWeighted("a", math.NaN())
+	want := []Completion{{"b", 4}, {"c", 3}}
+	if got := myTrie.TopK("", 2); !slices.EqualFunc(got, want, sameCompletion) {
+		t.Errorf("TopK after setting a NaN weight = %v, want %v", got, want)
+	}
+	checkCaches(t, myTrie)
+
+	// Going back from NaN to a number raises the weight again
+	myTrie.InsertWeighted("a", 10)
+	want = []Completion{{"a", 10}, {"b", 4}}
+	if got := myTrie.TopK("", 2); !slices.EqualFunc(got, want, sameCompletion) {
+		t.Errorf("TopK after replacing a NaN weight = %v, want %v", got, want)
+	}
+	checkCaches(t, myTrie)
+}
+
+// TestTopKCacheSizes tests that every cache size, including none, gives the same answers
+func TestTopKCacheSizes(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	words := randomWords(r, 500, "abc", 6)
+
+	for _, size := range []int{0, 1, 3, 10} {
+		t.Run(fmt.Sprintf("cache=%d", size), func(t *testing.T) {
+			myTrie := New[struct{}](WithTopKCache(size))
+			reference := New[struct{}](WithTopKCache(0))
+			for _, word := range words {
+				weight := float64(r.IntN(20))
+				myTrie.InsertWeighted(word, weight)
+				reference.InsertWeighted(word, weight)
+			}
+
+			// Raise, lower and delete weights at random, checking every cache as we go
+			for i := range 300 {
+				word := words[r.IntN(len(words))]
+				switch op := r.IntN(4); op {
+				case 0:
+					myTrie.Delete(word)
+					reference.Delete(word)
+				case 1:
+					myTrie.Insert(word)
+					reference.Insert(word)
+				default:
+					weight := float64(r.IntN(20))
+					if r.IntN(10) == 0 {
+						weight = math.NaN()
+					}
+					myTrie.InsertWeighted(word, weight)
+					reference.InsertWeighted(word, weight)
+				}
+				if i%50 == 0 {
+					checkCaches(t, myTrie)
+				}
+			}
+			checkCaches(t, myTrie)
+
+			for _, prefix := range []string{"", "a", "ab", "abc", "cc", "bca"} {
+				for _, k := range []int{1, 2, 5, 20} {
+					if got, want := myTrie.TopK(prefix, k), reference.TopK(prefix, k); !slices.EqualFunc(got, want, sameCompletion) {
+						t.Errorf("TopK(%q, %d) = %v, want %v", prefix, k, got, want)
+					}
+				}
+			}
+		})
+	}
+}
+
+// TestTopKWithoutCache tests that nodes cache nothing unless WithTopKCache asks for it
+func TestTopKWithoutCache(t *testing.T) {
+	myTrie := InitTrie()
+	for word, weight := range map[string]float64{"car": 5, "cat": 9, "care": 7} {
+		myTrie.InsertWeighted(word, weight)
+	}
+	myTrie.Delete("car")
+	checkCaches(t, myTrie)
+
+	want := []Completion{{"cat", 9}, {"care", 7}}
+	if got := myTrie.TopK("ca", 2); !slices.Equal(got, want) {
+		t.Errorf("TopK without a cache = %v, want %v", got, want)
+	}
+}
+
+func TestWithTopKCacheNegative(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("New with a negative cache size did not panic")
+		}
+	}()
+	New[struct{}](WithTopKCache(-1))
+}
+
+// randomWords returns n random words of 1 to maxLen runes drawn from the alphabet
+func randomWords(r *rand.Rand, n int, alphabet string, maxLen int) []string {
+	runes := []rune(alphabet)
+	words := make([]string, n)
+	for i := range words {
+		word := make([]rune, 1+r.IntN(maxLen))
+		for j := range word {
+			word[j] = runes[r.IntN(len(runes))]
+		}
+		words[i] = string(word)
+	}
+	return words
+}
+
+// BenchmarkTopK compares answering from the per-node caches with scanning the subtree
+func BenchmarkTopK(b *testing.B) {
+	r := rand.New(rand.NewPCG(3, 4))
+	words := randomWords(r, 200_000, "abcdefghijklmnopqrstuvwxyz", 10)
+
+	for _, size := range []int{0, 10} {
+		myTrie := New[struct{}](WithTopKCache(size))
+		for _, word := range words {
+			myTrie.InsertWeighted(word, r.Float64())
+		}
+
+		for _, prefix := range []string{"", "q", "qu"} {
+			b.Run(fmt.Sprintf("cache=%d/prefix=%q", size, prefix), func(b *testing.B) {
+				for b.Loop() {
+					myTrie.TopK(prefix, 10)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package trie
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"unicode/utf8"
+)
+
+// FuzzyMatch is a word together with its edit distance from a query, as returned by FuzzySearch
+// and Suggest
+type FuzzyMatch struct {
+	Word     string
+	Distance int
+}
+
+// FuzzySearch returns the words within maxDistance edits of word, closest first, breaking ties in
+// lexicographic order
+// The distance is the Levenshtein distance counted in runes: the number of single-rune insertions,
+// deletions and substitutions that turn one word into the other
+// Every node extends the dynamic-programming row of its parent by one rune, so shared prefixes are
+// only computed once, and a subtree is skipped as soon as no entry of its row is within the bound
+// Time complexity: O(n × v) where n is the length of the word and v the number of nodes visited,
+// which is far smaller than the Trie for small distances
+// Parameters:
+//   - word: The word to look up; it goes through the same normalization and case folding as keys
+//   - maxDistance: The largest distance to accept; a negative bound matches nothing
+func (t *Trie[V]) FuzzySearch(word string, maxDistance int) []FuzzyMatch {
+	matches := []FuzzyMatch{}
+	if maxDistance < 0 {
+		return matches
+	}
+
+	t.fuzzyWalk([]rune(t.key(word)), func() int { return maxDistance }, func(m FuzzyMatch) {
+		matches = append(matches, m)
+	})
+	slices.SortFunc(matches, compareFuzzyMatches)
+	return matches
+}
+
+// Suggest returns the k words closest to word, closest first, breaking ties in lexicographic order
+// It is a FuzzySearch whose bound shrinks to the distance of the k-th best word found so far
+// Time complexity: O(n × v) where n is the length of the word and v the number of nodes visited
+// Parameters:
+//   - word: The word to look up; it goes through the same normalization and case folding as keys
+//   - k: The number of suggestions; fewer are returned if the Trie holds fewer words
+func (t *Trie[V]) Suggest(word string, k int) []FuzzyMatch {
+	best := []FuzzyMatch{}
+	if k <= 0 {
+		return best
+	}
+
+	bound := func() int {
+		if len(best) < k {
+			return math.MaxInt // No bound until k words are found
+		}
+		return best[k-1].Distance
+	}
+	t.fuzzyWalk([]rune(t.key(word)), bound, func(m FuzzyMatch) {
+		// Keep the k best matches in order
+		i, _ := slices.BinarySearchFunc(best, m, compareFuzzyMatches)
+		if i < k {
+			best = slices.Insert(best, i, m)
+			best = best[:min(len(best), k)]
+		}
+	})
+	return best
+}
+
+// fuzzyWalk visits the words within bound() edits of query in lexicographic order
+// bound is consulted at every node, so visit may tighten it as matches come in
+func (t *Trie[V]) fuzzyWalk(query []rune, bound func() int, visit func(FuzzyMatch)) {
+	// step computes the row of node, reached by the rune char from a parent with row prev, and
+	// walks on into the subtree unless every entry of the row is over the bound
+	// row[j] is the distance between the word spelled so far and the first j runes of the query
+	var step func(node *Node[V], char rune, prefix []byte, prev []int)
+	step = func(node *Node[V], char rune, prefix []byte, prev []int) {
+		prefix = utf8.AppendRune(prefix, char)
+
+		row := make([]int, len(prev))
+		row[0] = prev[0] + 1
+		rowMin := row[0]
+		for j := 1; j < len(row); j++ {
+			substitute := prev[j-1]
+			if query[j-1] != char {
+				substitute++
+			}
+			row[j] = min(prev[j]+1, row[j-1]+1, substitute) // Delete, insert or substitute
+			rowMin = min(rowMin, row[j])
+		}
+
+		if node.isEnd && row[len(row)-1] <= bound() {
+			visit(FuzzyMatch{Word: string(prefix), Distance: row[len(row)-1]})
+		}
+		// Entries never decrease further down, so no word below can come within the bound
+		if rowMin > bound() {
+			return
+		}
+		for _, c := range node.children {
+			step(c.node, c.char, prefix, row)
+		}
+	}
+
+	// At the root the word is empty, so it takes j insertions to reach the first j runes
+	row := make([]int, len(query)+1)
+	for j := range row {
+		row[j] = j
+	}
+	if t.root.isEnd && row[len(query)] <= bound() {
+		visit(FuzzyMatch{Word: "", Distance: row[len(query)]})
+	}
+	for _, c := range t.root.children {
+		step(c.node, c.char, []byte{}, row)
+	}
+}
+
+// compareFuzzyMatches orders matches closest first, then by word
+func compareFuzzyMatches(a, b FuzzyMatch) int {
+	return cmp.Or(cmp.Compare(a.Distance, b.Distance), cmp.Compare(a.Word, b.Word))
+}
@@ -0,0 +1,178 @@
+package trie
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// levenshtein computes the edit distance between two words in runes, without a trie
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := range ra {
+		row := make([]int, len(rb)+1)
+		row[0] = i + 1
+		for j := range rb {
+			substitute := prev[j]
+			if ra[i] != rb[j] {
+				substitute++
+			}
+			row[j+1] = min(prev[j+1]+1, row[j]+1, substitute)
+		}
+		prev = row
+	}
+	return prev[len(rb)]
+}
+
+// bruteForceMatches ranks every word of the Trie by its distance from word
+func bruteForceMatches[V any](trie *Trie[V], word string) []FuzzyMatch {
+	matches := []FuzzyMatch{}
+	for _, w := range trie.ListWords() {
+		matches = append(matches, FuzzyMatch{Word: w, Distance: levenshtein(w, word)})
+	}
+	slices.SortFunc(matches, compareFuzzyMatches)
+	return matches
+}
+
+// TestFuzzySearch tests finding the words within an edit distance
+func TestFuzzySearch(t *testing.T) {
+	myTrie := InitTrie()
+	for _, word := range []string{"cat", "cart", "care", "car", "cast", "dog", "act", "scat", "café"} {
+		myTrie.Insert(word)
+	}
+
+	tests := []struct {
+		name        string
+		word        string
+		maxDistance int
+		want        []FuzzyMatch
+	}{
+		{"Exact only", "cat", 0, []FuzzyMatch{{"cat", 0}}},
+		{"One edit", "cat", 1, []FuzzyMatch{{"cat", 0}, {"car", 1}, {"cart", 1}, {"cast", 1}, {"scat", 1}}},
+		{"Transposition costs two", "cta", 1, []FuzzyMatch{}},
+		{"Misspelling", "crae", 2, []FuzzyMatch{{"car", 2}, {"care", 2}, {"cat", 2}}},
+		{"Multi-byte rune counts once", "cafe", 1, []FuzzyMatch{{"café", 1}, {"care", 1}}},
+		{"Nothing close", "zebra", 2, []FuzzyMatch{}},
+		{"Negative bound", "cat", -1, []FuzzyMatch{}},
+		{"Empty query", "", 3, []FuzzyMatch{{"act", 3}, {"car", 3}, {"cat", 3}, {"dog", 3}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := myTrie.FuzzySearch(tt.word, tt.maxDistance); !slices.Equal(got, tt.want) {
+				t.Errorf("FuzzySearch(%q, %d) = %v, want %v", tt.word, tt.maxDistance, got, tt.want)
+			}
+		})
+	}
+
+	// The empty word is a candidate like any other
+	myTrie.Insert("")
+	if got := myTrie.FuzzySearch("a", 1); !slices.Equal(got, []FuzzyMatch{{"", 1}}) {
+		t.Errorf("FuzzySearch(%q, 1) with the empty word = %v, want [{ 1}]", "a", got)
+	}
+
+	// The query goes through the same case folding as the words
+	folded := New[struct{}](WithCaseFolding())
+	folded.Insert("Hello")
+	if got := folded.FuzzySearch("HELO", 1); !slices.Equal(got, []FuzzyMatch{{"hello", 1}}) {
+		t.Errorf("FuzzySearch with case folding = %v, want [{hello 1}]", got)
+	}
+}
+
+// TestSuggest tests finding the nearest words
+func TestSuggest(t *testing.T) {
+	myTrie := InitTrie()
+	for _, word := range []string{"apple", "apply", "ample", "maple", "angle", "apples", "banana"} {
+		myTrie.Insert(word)
+	}
+
+	tests := []struct {
+		word string
+		k    int
+		want []FuzzyMatch
+	}{
+		{"appel", 1, []FuzzyMatch{{"apple", 2}}},
+		{"appel", 3, []FuzzyMatch{{"apple", 2}, {"apples", 2}, {"apply", 2}}},
+		{"apple", 3, []FuzzyMatch{{"apple", 0}, {"ample", 1}, {"apples", 1}}},
+		{"bandana", 1, []FuzzyMatch{{"banana", 1}}},
+		{"xyz", 10, bruteForceMatches(myTrie, "xyz")}, // More than the Trie holds
+		{"apple", 0, []FuzzyMatch{}},
+	}
+
+	for _, tt := range tests {
+		if got := myTrie.Suggest(tt.word, tt.k); !slices.Equal(got, tt.want) {
+			t.Errorf("Suggest(%q, %d) = %v, want %v", tt.word, tt.k, got, tt.want)
+		}
+	}
+
+	if got := InitTrie().Suggest("apple", 3); len(got) != 0 {
+		t.Errorf("Suggest on an empty trie = %v, want none", got)
+	}
+}
+
+// TestFuzzyAgainstBruteForce compares FuzzySearch and Suggest with ranking every word
+func TestFuzzyAgainstBruteForce(t *testing.T) {
+	r := rand.New(rand.NewPCG(5, 6))
+	myTrie := InitTrie()
+	for _, word := range randomWords(r, 2000, "abcdé", 7) {
+		myTrie.Insert(word)
+	}
+
+	for _, query := range randomWords(r, 50, "abcdéf", 8) {
+		all := bruteForceMatches(myTrie, query)
+
+		for maxDistance := range 4 {
+			want := []FuzzyMatch{}
+			for _, m := range all {
+				if m.Distance <= maxDistance {
+					want = append(want, m)
+				}
+			}
+			if got := myTrie.FuzzySearch(query, maxDistance); !slices.Equal(got, want) {
+				t.Errorf("FuzzySearch(%q, %d) = %v, want %v", query, maxDistance, got, want)
+			}
+		}
+
+		for _, k := range []int{1, 5, 20} {
+			if got := myTrie.Suggest(query, k); !slices.Equal(got, all[:k]) {
+				t.Errorf("Suggest(%q, %d) = %v, want %v", query, k, got, all[:k])
+			}
+		}
+	}
+}
+
+// BenchmarkFuzzySearch compares the pruned trie walk with computing the distance to every word
+func BenchmarkFuzzySearch(b *testing.B) {
+	r := rand.New(rand.NewPCG(7, 8))
+	myTrie := InitTrie()
+	for _, word := range randomWords(r, 100_000, "abcdefghijklmnopqrstuvwxyz", 10) {
+		myTrie.Insert(word)
+	}
+	words := myTrie.ListWords()
+	query := words[len(words)/2]
+
+	for _, maxDistance := range []int{1, 2} {
+		b.Run(fmt.Sprintf("trie/distance=%d", maxDistance), func(b *testing.B) {
+			for b.Loop() {
+				myTrie.FuzzySearch(query, maxDistance)
+			}
+		})
+	}
+	b.Run("scan", func(b *testing.B) {
+		for b.Loop() {
+			for _, w := range words {
+				levenshtein(w, query)
+			}
+		}
+	})
+	b.Run("suggest/k=5", func(b *testing.B) {
+		for b.Loop() {
+			myTrie.Suggest(query, 5)
+		}
+	})
+}
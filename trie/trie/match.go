@@ -0,0 +1,91 @@
+package trie
+
+import (
+	"slices"
+	"unicode/utf8"
+)
+
+// Match returns the words that match the pattern, in lexicographic order
+// In the pattern, "?" stands for any single rune and "*" for any run of runes, including none;
+// every other rune stands for itself, so "c?t" matches "cat" and "cut", and "re*ing" matches
+// "reading" and "reing" but not "ring"
+// The pattern goes through the same normalization and case folding as keys, and has no way to
+// match a literal "?" or "*" only
+// The trie is walked with the set of pattern positions reached so far, so a branch is left as soon
+// as no position is alive, a literal rune follows a single child, and once the rest of the pattern
+// is only stars the whole subtree matches without further checks
+// Time complexity: O(q × v) where q is the length of the pattern and v the number of nodes visited;
+// a pattern that starts with literal runes only visits the subtree below them
+func (t *Trie[V]) Match(pattern string) []string {
+	result := []string{}
+	p := []rune(t.key(pattern))
+
+	// Positions from tail on are all stars: reaching one of them matches every word below
+	tail := len(p)
+	for tail > 0 && p[tail-1] == '*' {
+		tail--
+	}
+
+	var walk func(node *Node[V], prefix []byte, states []int)
+	walk = func(node *Node[V], prefix []byte, states []int) {
+		last := states[len(states)-1]
+		if tail < len(p) && last >= tail {
+			walkWords(node, prefix, func(word string, _ V) bool {
+				result = append(result, word)
+				return true
+			})
+			return
+		}
+		if node.isEnd && last == len(p) {
+			result = append(result, string(prefix))
+		}
+
+		// With the pattern used up, nothing below can match
+		if len(states) == 1 && last == len(p) {
+			return
+		}
+		// A single literal position can only go on through one child
+		if s := states[0]; len(states) == 1 && p[s] != '?' && p[s] != '*' {
+			if next := node.child(p[s]); next != nil {
+				walk(next, utf8.AppendRune(prefix, p[s]), closeStates(p, []int{s + 1}))
+			}
+			return
+		}
+
+		for _, c := range node.children {
+			if next := stepStates(p, states, c.char); len(next) > 0 {
+				walk(c.node, utf8.AppendRune(prefix, c.char), next)
+			}
+		}
+	}
+	walk(t.root, []byte{}, closeStates(p, []int{0}))
+	return result
+}
+
+// stepStates returns the pattern positions reached from states by reading the rune char
+func stepStates(p []rune, states []int, char rune) []int {
+	var next []int
+	for _, s := range states {
+		switch {
+		case s == len(p):
+			// The whole pattern is used up, nothing more can be read
+		case p[s] == '*':
+			next = append(next, s) // The star takes the rune and may take more
+		case p[s] == '?' || p[s] == char:
+			next = append(next, s+1)
+		}
+	}
+	return closeStates(p, next)
+}
+
+// closeStates adds to states every position reachable by letting a star match nothing, and
+// returns them sorted and without duplicates
+func closeStates(p []rune, states []int) []int {
+	for i := 0; i < len(states); i++ {
+		if s := states[i]; s < len(p) && p[s] == '*' {
+			states = append(states, s+1)
+		}
+	}
+	slices.Sort(states)
+	return slices.Compact(states)
+}
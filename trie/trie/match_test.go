@@ -0,0 +1,128 @@
+package trie
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// globMatch reports whether the word matches the pattern, without a trie
+func globMatch(pattern, word string) bool {
+	p, w := []rune(pattern), []rune(word)
+	// match[j] reports whether the pattern so far matches the first j runes of the word
+	match := make([]bool, len(w)+1)
+	match[0] = true
+	for _, c := range p {
+		next := make([]bool, len(w)+1)
+		for j := range next {
+			switch {
+			case c == '*':
+				next[j] = match[j] || (j > 0 && next[j-1])
+			case j > 0:
+				next[j] = match[j-1] && (c == '?' || c == w[j-1])
+			}
+		}
+		match = next
+	}
+	return match[len(w)]
+}
+
+// scanMatch filters ListWords with globMatch, the naive alternative to Match
+func scanMatch[V any](trie *Trie[V], pattern string) []string {
+	result := []string{}
+	for _, word := range trie.ListWords() {
+		if globMatch(pattern, word) {
+			result = append(result, word)
+		}
+	}
+	return result
+}
+
+// TestMatch tests wildcard patterns
+func TestMatch(t *testing.T) {
+	myTrie := InitTrie()
+	words := []string{"cat", "cut", "cot", "coat", "ct", "reading", "ring", "reing", "rebooting", "red", "日本語", "日本"}
+	for _, word := range words {
+		myTrie.Insert(word)
+	}
+
+	tests := []struct {
+		pattern string
+		want    []string
+	}{
+		{"c?t", []string{"cat", "cot", "cut"}},
+		{"c??t", []string{"coat"}},
+		{"c*t", []string{"cat", "coat", "cot", "ct", "cut"}},
+		{"re*ing", []string{"reading", "rebooting", "reing"}},
+		{"*ing", []string{"reading", "rebooting", "reing", "ring"}},
+		{"re*", []string{"reading", "rebooting", "red", "reing"}},
+		{"r**d", []string{"red"}},
+		{"*", []string{"cat", "coat", "cot", "ct", "cut", "reading", "rebooting", "red", "reing", "ring", "日本", "日本語"}},
+		{"???", []string{"cat", "cot", "cut", "red", "日本語"}},
+		{"日?", []string{"日本"}},
+		{"*語", []string{"日本語"}},
+		{"cat", []string{"cat"}},
+		{"ca", []string{}},
+		{"x*", []string{}},
+		{"", []string{}},
+	}
+
+	for _, tt := range tests {
+		if got := myTrie.Match(tt.pattern); !slices.Equal(got, tt.want) {
+			t.Errorf("Match(%q) = %q, want %q", tt.pattern, got, tt.want)
+		}
+	}
+
+	// The empty pattern matches the empty word, and so does a lone star
+	myTrie.Insert("")
+	if got := myTrie.Match(""); !slices.Equal(got, []string{""}) {
+		t.Errorf("Match(%q) with the empty word = %q, want [\"\"]", "", got)
+	}
+	if got := myTrie.Match("*"); len(got) != len(words)+1 || got[0] != "" {
+		t.Errorf("Match(%q) with the empty word = %q, want every word", "*", got)
+	}
+
+	// The pattern goes through the same case folding as the words
+	folded := New[struct{}](WithCaseFolding())
+	folded.Insert("Reading")
+	if got := folded.Match("RE*ING"); !slices.Equal(got, []string{"reading"}) {
+		t.Errorf("Match with case folding = %q, want [reading]", got)
+	}
+}
+
+// TestMatchAgainstScan compares Match with filtering every word
+func TestMatchAgainstScan(t *testing.T) {
+	r := rand.New(rand.NewPCG(9, 10))
+	myTrie := InitTrie()
+	for _, word := range randomWords(r, 3000, "abcé", 8) {
+		myTrie.Insert(word)
+	}
+
+	for _, pattern := range randomWords(r, 300, "abcé?**", 7) {
+		if got, want := myTrie.Match(pattern), scanMatch(myTrie, pattern); !slices.Equal(got, want) {
+			t.Errorf("Match(%q) = %q, want %q", pattern, got, want)
+		}
+	}
+}
+
+// BenchmarkMatch compares walking the trie with scanning ListWords
+func BenchmarkMatch(b *testing.B) {
+	r := rand.New(rand.NewPCG(11, 12))
+	myTrie := InitTrie()
+	for _, word := range randomWords(r, 100_000, "abcdefghijklmnopqrstuvwxyz", 10) {
+		myTrie.Insert(word)
+	}
+
+	for _, pattern := range []string{"c?t", "re*ing", "*ing", "q*"} {
+		b.Run("trie/"+pattern, func(b *testing.B) {
+			for b.Loop() {
+				myTrie.Match(pattern)
+			}
+		})
+		b.Run("scan/"+pattern, func(b *testing.B) {
+			for b.Loop() {
+				scanMatch(myTrie, pattern)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package trie
+
+import "unicode/utf8"
+
+// Put stores the value for the key, replacing the value if the key is already present
+// A new key gets weight 0, and an existing key keeps its weight
+// Time complexity: O(m log k) where m is the length of the key, plus the upkeep of the top-k
+// caches when the key is new (see InsertWeighted)
+func (t *Trie[V]) Put(key string, value V) {
+	t.insert(t.key(key), 0, false).value = value
+}
+
+// Get returns the value stored for the key
+// Time complexity: O(m log k)
+// Returns:
+//   - V: The stored value, or the zero value if the key is absent
+//   - bool: True if the key was found
+func (t *Trie[V]) Get(key string) (V, bool) {
+	if n := t.find(t.key(key)); n != nil && n.isEnd {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// LongestPrefixOf returns the longest key in the Trie that is a prefix of s, and its value
+// This is the lookup of a routing table: with the keys "/api/" and "/api/users/", the path
+// "/api/users/42" matches "/api/users/". Prefixes are matched rune by rune, so keys that should
+// only match whole path segments must end with the separator
+// The key is returned in its stored form, after normalization and case folding
+// Time complexity: O(n log k) where n is the length of s; the walk stops where the Trie has no
+// more matching runes
+// Returns:
+//   - string: The longest matching key, or "" if there is none
+//   - V: Its value, or the zero value if there is none
+//   - bool: True if some key, possibly the empty key, is a prefix of s
+func (t *Trie[V]) LongestPrefixOf(s string) (string, V, bool) {
+	s = t.key(s)
+	best, end, found := t.root, 0, t.root.isEnd
+
+	currentNode := t.root
+	for i, char := range s {
+		if currentNode = currentNode.child(char); currentNode == nil {
+			break
+		}
+		if currentNode.isEnd {
+			best, end, found = currentNode, i+utf8.RuneLen(char), true
+		}
+	}
+
+	if !found {
+		var zero V
+		return "", zero, false
+	}
+	return s[:end], best.value, true
+}
+
+// WalkPrefix calls fn for every key that starts with the prefix, with its value, in lexicographic
+// order, and stops as soon as fn returns false
+// Only the subtree below the prefix is visited. The Trie must not be modified during the walk
+// Time complexity: O(p log k + s) where s is the size of the part of the subtree that is visited
+// Parameters:
+//   - prefix: The prefix to walk; the empty prefix walks every key
+//   - fn: Called with each key, in its stored form, and its value; returns false to stop
+func (t *Trie[V]) WalkPrefix(prefix string, fn func(key string, value V) bool) {
+	prefix = t.key(prefix)
+	if node := t.find(prefix); node != nil {
+		walkWords(node, []byte(prefix), fn)
+	}
+}
@@ -0,0 +1,164 @@
+package trie
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestPutGet tests storing and replacing values
+func TestPutGet(t *testing.T) {
+	myTrie := New[int]()
+	myTrie.Put("car", 1)
+	myTrie.Put("cart", 2)
+	myTrie.Put("日本", 3)
+	myTrie.Put("", 4)
+
+	tests := []struct {
+		key       string
+		wantValue int
+		wantFound bool
+	}{
+		{"car", 1, true},
+		{"cart", 2, true},
+		{"日本", 3, true},
+		{"", 4, true},
+		{"ca", 0, false},    // Only a prefix
+		{"carts", 0, false}, // Runs past the path
+		{"日", 0, false},
+		{"dog", 0, false},
+	}
+
+	for _, tt := range tests {
+		if got, found := myTrie.Get(tt.key); got != tt.wantValue || found != tt.wantFound {
+			t.Errorf("Get(%q) = (%d, %v), want (%d, %v)", tt.key, got, found, tt.wantValue, tt.wantFound)
+		}
+	}
+
+	// Put replaces the value, Insert keeps it
+	myTrie.Put("car", 10)
+	myTrie.Insert("car")
+	if got, _ := myTrie.Get("car"); got != 10 {
+		t.Errorf("Get(%q) after Put and Insert = %d, want 10", "car", got)
+	}
+	if myTrie.Count() != 4 {
+		t.Errorf("Count() = %d, want 4", myTrie.Count())
+	}
+
+	// A word added by Insert has the zero value
+	myTrie.Insert("ca")
+	if got, found := myTrie.Get("ca"); got != 0 || !found {
+		t.Errorf("Get(%q) after Insert = (%d, %v), want (0, true)", "ca", got, found)
+	}
+
+	// Deleting a key forgets its value, even if it is put back with Insert
+	myTrie.Delete("cart")
+	myTrie.Insert("cart")
+	if got, _ := myTrie.Get("cart"); got != 0 {
+		t.Errorf("Get(%q) after Delete and Insert = %d, want 0", "cart", got)
+	}
+
+	// Keys go through the same case folding as words
+	folded := New[string](WithCaseFolding())
+	folded.Put("GET /Users", "listUsers")
+	if got, found := folded.Get("get /users"); got != "listUsers" || !found {
+		t.Errorf("Get with case folding = (%q, %v), want (%q, true)", got, found, "listUsers")
+	}
+}
+
+// TestLongestPrefixOf tests routing lookups
+func TestLongestPrefixOf(t *testing.T) {
+	routes := New[string]()
+	routes.Put("/", "root")
+	routes.Put("/api/", "api")
+	routes.Put("/api/users/", "users")
+	routes.Put("/api/users/me", "me")
+	routes.Put("/static/", "static")
+
+	tests := []struct {
+		path      string
+		wantKey   string
+		wantValue string
+		wantFound bool
+	}{
+		{"/api/users/42", "/api/users/", "users", true},
+		{"/api/users/", "/api/users/", "users", true},
+		{"/api/users/me", "/api/users/me", "me", true},
+		{"/api/users/mess", "/api/users/me", "me", true}, // Not segment-aware
+		{"/api/user", "/api/", "api", true},
+		{"/api/orders", "/api/", "api", true},
+		{"/static/app.js", "/static/", "static", true},
+		{"/about", "/", "root", true},
+		{"/", "/", "root", true},
+		{"api/", "", "", false},
+		{"", "", "", false},
+	}
+
+	for _, tt := range tests {
+		key, value, found := routes.LongestPrefixOf(tt.path)
+		if key != tt.wantKey || value != tt.wantValue || found != tt.wantFound {
+			t.Errorf("LongestPrefixOf(%q) = (%q, %q, %v), want (%q, %q, %v)",
+				tt.path, key, value, found, tt.wantKey, tt.wantValue, tt.wantFound)
+		}
+	}
+
+	// The empty key is a prefix of everything
+	commands := New[int]()
+	commands.Put("", 0)
+	commands.Put("git", 1)
+	commands.Put("git commit", 2)
+	for s, want := range map[string]int{"ls -l": 0, "": 0, "gi": 0, "git status": 1, "git commit -m": 2} {
+		if _, got, found := commands.LongestPrefixOf(s); got != want || !found {
+			t.Errorf("LongestPrefixOf(%q) = (%d, %v), want (%d, true)", s, got, found, want)
+		}
+	}
+
+	// Multi-byte runes and case folding: the key comes back in its stored form
+	folded := New[int](WithCaseFolding())
+	folded.Put("Ünï/", 1)
+	if key, got, found := folded.LongestPrefixOf("ÜNÏ/Köln"); key != "ünï/" || got != 1 || !found {
+		t.Errorf("LongestPrefixOf with case folding = (%q, %d, %v), want (%q, 1, true)", key, got, found, "ünï/")
+	}
+}
+
+// TestWalkPrefix tests visiting the keys below a prefix
+func TestWalkPrefix(t *testing.T) {
+	myTrie := New[int]()
+	for i, key := range []string{"team", "tea", "ten", "to", "inn", "te"} {
+		myTrie.Put(key, i)
+	}
+
+	type entry struct {
+		key   string
+		value int
+	}
+	walk := func(prefix string, limit int) []entry {
+		entries := []entry{}
+		myTrie.WalkPrefix(prefix, func(key string, value int) bool {
+			entries = append(entries, entry{key, value})
+			return len(entries) != limit
+		})
+		return entries
+	}
+
+	tests := []struct {
+		name   string
+		prefix string
+		limit  int
+		want   []entry
+	}{
+		{"Whole subtree", "te", -1, []entry{{"te", 5}, {"tea", 1}, {"team", 0}, {"ten", 2}}},
+		{"Stops early", "te", 2, []entry{{"te", 5}, {"tea", 1}}},
+		{"Key equal to the prefix", "team", -1, []entry{{"team", 0}}},
+		{"Every key", "", -1, []entry{{"inn", 4}, {"te", 5}, {"tea", 1}, {"team", 0}, {"ten", 2}, {"to", 3}}},
+		{"Missing prefix", "x", -1, []entry{}},
+		{"Prefix longer than any key", "teams", -1, []entry{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := walk(tt.prefix, tt.limit); !slices.Equal(got, tt.want) {
+				t.Errorf("WalkPrefix(%q) visited %v, want %v", tt.prefix, got, tt.want)
+			}
+		})
+	}
+}
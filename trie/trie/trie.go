@@ -1,170 +1,223 @@
 package trie
 
-// AlphabetSize is the size of the lowercase English alphabet
-const AlphabetSize = 26
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
 
-// Node represents a node in the Trie
-type Node struct {
-	children [AlphabetSize]*Node // Child nodes for each letter
-	isEnd    bool                // Flag indicating if this node represents the end of a word
+// Node represents a node in a Trie holding values of type V
+type Node[V any] struct {
+	children []child[V]   // Child nodes, sorted by rune so lookups can binary search
+	isEnd    bool         // Flag indicating if this node represents the end of a word
+	value    V            // Value stored with the word ending here
+	weight   float64      // Score of the word ending here, used to rank completions
+	top      []Completion // Best-ranked words in this subtree, at most the Trie's cache size
 }
 
-// Trie is a data structure for efficient retrieval of words
-type Trie struct {
-	root *Node // Root node of the Trie
+// child is an edge from a Node to the node for the next rune of a word
+type child[V any] struct {
+	char rune
+	node *Node[V]
 }
 
-// InitTrie creates and initializes a new Trie
-func InitTrie() *Trie {
-	result := &Trie{
-		root: &Node{},
+// Trie is a data structure for efficient retrieval of words, each of which can carry a value of
+// type V, which makes it a map from strings to V that also answers prefix queries
+// Use Trie[struct{}], as returned by InitTrie, to store plain words
+// Words are sequences of Unicode code points: any UTF-8 string can be stored, and every node only
+// holds entries for the runes that actually follow it, so a mixed-case or non-Latin alphabet costs
+// no more memory than lowercase English
+type Trie[V any] struct {
+	root      *Node[V]            // Root node of the Trie
+	foldCase  bool                // Whether keys are case-folded before use
+	normalize func(string) string // Applied to keys before use, nil for none
+	cacheSize int                 // Number of top completions cached in every node
+}
+
+// config holds the settings collected from Options before a Trie is built
+type config struct {
+	foldCase  bool
+	normalize func(string) string
+	cacheSize int
+}
+
+// Option configures a Trie created by New
+type Option func(*config)
+
+// WithCaseFolding makes the Trie case-insensitive: every word and prefix is folded to lower case
+// before use, so Insert("Hello") is found by Search("HELLO") and listed as "hello"
+// Folding maps every case variant of a letter to the same rune, including special forms such as
+// the Kelvin sign, which folds to "k"
+func WithCaseFolding() Option {
+	return func(c *config) {
+		c.foldCase = true
+	}
+}
+
+// WithNormalizer applies normalize to every word and prefix before use, before any case folding
+// A typical normalizer is Unicode NFC from golang.org/x/text/unicode/norm (norm.NFC.String), so
+// that "é" written as one code point or as "e" plus a combining accent is the same word
+func WithNormalizer(normalize func(string) string) Option {
+	return func(c *config) {
+		c.normalize = normalize
+	}
+}
+
+// WithTopKCache makes every node cache its n best-ranked completions; without it there is no cache
+// TopK answers from the cache when k is at most n, and scans the subtree otherwise; the cache
+// costs memory in every node and time on every Insert and Delete, so only ask for it when TopK
+// is called often. n = 0 disables the cache. It panics if n is negative
+func WithTopKCache(n int) Option {
+	return func(c *config) {
+		c.cacheSize = n
+	}
+}
+
+// New creates and initializes a new Trie whose words carry values of type V
+// It panics if an option is invalid
+// Parameters:
+//   - opts: Options such as WithCaseFolding, WithNormalizer and WithTopKCache
+func New[V any](opts ...Option) *Trie[V] {
+	var c config
+	for _, opt := range opts {
+		opt(&c)
+	}
+	if c.cacheSize < 0 {
+		panic(fmt.Sprintf("trie: negative top-k cache size %d", c.cacheSize))
+	}
+	return &Trie[V]{
+		root:      &Node[V]{},
+		foldCase:  c.foldCase,
+		normalize: c.normalize,
+		cacheSize: c.cacheSize,
 	}
-	return result
+}
+
+// InitTrie creates and initializes a new case-sensitive Trie of plain words without normalization
+func InitTrie() *Trie[struct{}] {
+	return New[struct{}]()
 }
 
 // Insert adds a new word to the Trie
-func (t *Trie) Insert(word string) {
+// A new word gets weight 0 and the zero value, and a word that is already present keeps its
+// weight and value
+// Invalid UTF-8 bytes in the word are stored as the replacement rune U+FFFD
+// Time complexity: O(m log k) where m is the length of the word and k the number of distinct
+// runes following a node, plus the upkeep of the top-k caches (see InsertWeighted)
+func (t *Trie[V]) Insert(word string) {
+	t.insert(t.key(word), 0, false)
+}
+
+// insert adds the word, which must already be a key, and sets its weight if it is new or if
+// setWeight is true. It returns the word's node
+func (t *Trie[V]) insert(word string, weight float64, setWeight bool) *Node[V] {
 	currentNode := t.root
+	var path []*Node[V] // Nodes from the root to the word's node, only kept for the caches
+	if t.cacheSize > 0 {
+		path = append(path, currentNode)
+	}
 
 	for _, char := range word {
-		// Convert the character to an array index (0-25)
-		// For example:
-		// 'a' - 'a' = 0
-		// 'b' - 'a' = 1
-		// 'z' - 'a' = 25
-		// This maps each lowercase letter to an index in the children array,
-		// where 'a' is at index 0, 'b' is at index 1, and so on
-		charIndex := char - 'a'
-
 		// Create a new node if the path doesn't exist
-		if currentNode.children[charIndex] == nil {
-			currentNode.children[charIndex] = &Node{}
+		next := currentNode.child(char)
+		if next == nil {
+			next = currentNode.addChild(char)
+		}
+		currentNode = next
+		if t.cacheSize > 0 {
+			path = append(path, currentNode)
 		}
-		currentNode = currentNode.children[charIndex]
+	}
+
+	existed, oldWeight := currentNode.isEnd, currentNode.weight
+	if existed && !setWeight {
+		return currentNode
 	}
 	// Mark the end of the word
 	currentNode.isEnd = true
+	currentNode.weight = weight
+	// Compare like the caches do, so that a NaN weight counts as the lowest
+	t.updateCaches(path, word, existed && cmp.Compare(weight, oldWeight) < 0)
+	return currentNode
 }
 
 // Search checks if a word exists in the Trie
-func (t *Trie) Search(word string) bool {
-	currentNode := t.root
-
-	for _, char := range word {
-		// Convert the character to an array index (0-25)
-		// This is the same mapping used in Insert:
-		// 'a' (ASCII 97) - 'a' (ASCII 97) = 0
-		// 'b' (ASCII 98) - 'a' (ASCII 97) = 1
-		// And so on...
-		charIndex := char - 'a'
-
-		// Return false if the path doesn't exist
-		if currentNode.children[charIndex] == nil {
-			return false
-		}
-		currentNode = currentNode.children[charIndex]
-	}
+// Time complexity: O(m log k)
+func (t *Trie[V]) Search(word string) bool {
+	currentNode := t.find(t.key(word))
 	// Return true only if this is the end of a word
-	return currentNode.isEnd
+	return currentNode != nil && currentNode.isEnd
 }
 
 // StartsWith checks if any word in the Trie starts with the given prefix
-func (t *Trie) StartsWith(prefix string) bool {
-	currentNode := t.root
-
-	for _, char := range prefix {
-		// Convert the character to an array index (0-25)
-		// Using the same character-to-index mapping:
-		// The ASCII value of the current character minus the ASCII value of 'a'
-		// This gives us the relative position in the alphabet (0-25)
-		charIndex := char - 'a'
-
-		if currentNode.children[charIndex] == nil {
-			return false
-		}
-		currentNode = currentNode.children[charIndex]
-	}
-	return true
+// Time complexity: O(p log k) where p is the length of the prefix
+func (t *Trie[V]) StartsWith(prefix string) bool {
+	return t.find(t.key(prefix)) != nil
 }
 
 // Delete removes a word from the Trie if it exists
-func (t *Trie) Delete(word string) bool {
-	if word == "" {
-		if t.root.isEnd {
-			t.root.isEnd = false
-			return true
-		}
-		return false
-	}
+// Nodes that no longer lead to any word are removed with it
+// Time complexity: O(m log k)
+func (t *Trie[V]) Delete(word string) bool {
+	word = t.key(word)
 
-	// Use a different approach that tracks whether the word was found
+	// Use a helper that tracks whether the word was found
 	found := false
-	t.deleteHelper(t.root, word, 0, &found)
+	t.deleteHelper(t.root, []rune(word), &found)
+	if found && t.cacheSize > 0 {
+		t.updateCaches(t.path(word), word, true)
+	}
 	return found
 }
 
 // deleteHelper is a helper function for Delete
-// It modifies found to indicate if the word was found and deleted
-func (t *Trie) deleteHelper(node *Node, word string, depth int, found *bool) bool {
+// It modifies found to indicate if the word was found and deleted, and returns true if node
+// no longer leads to any word and can be removed by its parent
+func (t *Trie[V]) deleteHelper(node *Node[V], word []rune, found *bool) bool {
 	// Base case: end of the word
-	if depth == len(word) {
+	if len(word) == 0 {
 		// Word not found if this isn't marked as end of word
 		if !node.isEnd {
 			return false
 		}
 
 		// Mark as not end of word and indicate word was found
+		var zero V
 		node.isEnd = false
+		node.value = zero // Let go of the value
+		node.weight = 0
 		*found = true
 
 		// Return true if this node has no children and can be deleted
-		for _, child := range node.children {
-			if child != nil {
-				return false
-			}
-		}
-		return true
+		return len(node.children) == 0
 	}
 
-	// Get current character index
-	// Convert the character to its corresponding index in the children array
-	// This is the same mapping used throughout the Trie:
-	// Subtract the ASCII value of 'a' from the current character
-	// to get a zero-based index for the children array (0-25)
-	charIndex := word[depth] - 'a'
-	if node.children[charIndex] == nil {
+	next := node.child(word[0])
+	if next == nil {
 		// Path doesn't exist, word not found
 		return false
 	}
 
-	// Recursively delete in child node
-	shouldDeleteChild := t.deleteHelper(node.children[charIndex], word, depth+1, found)
-
-	// Delete the child node if needed
-	if shouldDeleteChild {
-		node.children[charIndex] = nil
-
+	// Recursively delete in child node, then drop the child if it became useless
+	if t.deleteHelper(next, word[1:], found) {
+		node.removeChild(word[0])
 		// Check if this node can be deleted too
-		if !node.isEnd {
-			for _, child := range node.children {
-				if child != nil {
-					return false
-				}
-			}
-			return true
-		}
+		return !node.isEnd && len(node.children) == 0
 	}
-
 	return false
 }
 
 // Count returns the number of words stored in the Trie
-func (t *Trie) Count() int {
+func (t *Trie[V]) Count() int {
 	return countWords(t.root)
 }
 
 // countWords is a helper function that counts words recursively
-func countWords(node *Node) int {
+func countWords[V any](node *Node[V]) int {
 	if node == nil {
 		return 0
 	}
@@ -174,36 +227,133 @@ func countWords(node *Node) int {
 		count = 1
 	}
 
-	for _, child := range node.children {
-		if child != nil {
-			count += countWords(child)
-		}
+	for _, c := range node.children {
+		count += countWords(c.node)
 	}
 
 	return count
 }
 
-// ListWords returns all words stored in the Trie
-func (t *Trie) ListWords() []string {
+// ListWords returns all words stored in the Trie, in lexicographic order
+// With case folding or a normalizer, the words are listed in their folded and normalized form
+func (t *Trie[V]) ListWords() []string {
 	result := []string{}
 	collectWords(t.root, "", &result)
 	return result
 }
 
 // collectWords is a helper function that collects words recursively
-func collectWords(node *Node, prefix string, result *[]string) {
+func collectWords[V any](node *Node[V], prefix string, result *[]string) {
 	if node.isEnd {
 		*result = append(*result, prefix)
 	}
 
-	for i, child := range node.children {
-		if child != nil {
-			// Convert the index back to the corresponding character
-			// This is the inverse of the charIndex calculation:
-			// Add the index (0-25) to the ASCII value of 'a'
-			// to get the actual character ('a' to 'z')
-			char := rune('a' + i)
-			collectWords(child, prefix+string(char), result)
+	for _, c := range node.children {
+		collectWords(c.node, prefix+string(c.char), result)
+	}
+}
+
+// All returns an iterator over the words stored in the Trie, in lexicographic order
+// Unlike ListWords, words are produced one at a time and iteration can stop early
+// The Trie must not be modified while iterating
+func (t *Trie[V]) All() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		walkWords(t.root, []byte{}, func(word string, _ V) bool {
+			return yield(word)
+		})
+	}
+}
+
+// walkWords visits the words below node and their values in lexicographic order
+// It returns false once yield asks to stop
+func walkWords[V any](node *Node[V], prefix []byte, yield func(string, V) bool) bool {
+	if node.isEnd && !yield(string(prefix), node.value) {
+		return false
+	}
+
+	for _, c := range node.children {
+		if !walkWords(c.node, utf8.AppendRune(prefix, c.char), yield) {
+			return false
+		}
+	}
+	return true
+}
+
+// key prepares a word or prefix for use as a path in the Trie, applying the normalizer and
+// case folding the Trie was built with
+// Keys are always valid UTF-8: each invalid byte becomes U+FFFD, as when ranging over the string
+func (t *Trie[V]) key(word string) string {
+	if t.normalize != nil {
+		word = t.normalize(word)
+	}
+	switch {
+	case t.foldCase:
+		word = strings.Map(foldRune, word)
+	case !utf8.ValidString(word):
+		word = strings.Map(func(r rune) rune { return r }, word)
+	}
+	return word
+}
+
+// foldRune maps a rune to a canonical lower-case form, so that all case variants of a letter
+// compare equal. Going through upper case first also catches letters whose lower-case mapping
+// differs from their fold, such as the long s "ſ", which folds to "s"
+func foldRune(r rune) rune {
+	return unicode.ToLower(unicode.ToUpper(r))
+}
+
+// path returns the nodes on the path spelled by word, starting with the root and ending with
+// the word's node, or earlier if the path is cut short
+func (t *Trie[V]) path(word string) []*Node[V] {
+	path := []*Node[V]{t.root}
+	for _, char := range word {
+		next := path[len(path)-1].child(char)
+		if next == nil {
+			break
+		}
+		path = append(path, next)
+	}
+	return path
+}
+
+// find returns the node at the end of the path spelled by word, or nil if there is none
+func (t *Trie[V]) find(word string) *Node[V] {
+	currentNode := t.root
+	for _, char := range word {
+		// Return nil if the path doesn't exist
+		if currentNode = currentNode.child(char); currentNode == nil {
+			return nil
 		}
 	}
+	return currentNode
+}
+
+// child returns the child of the node for the rune, or nil if there is none
+func (n *Node[V]) child(char rune) *Node[V] {
+	if i, ok := n.search(char); ok {
+		return n.children[i].node
+	}
+	return nil
+}
+
+// addChild creates an empty child for the rune, which must not exist yet, and returns it
+func (n *Node[V]) addChild(char rune) *Node[V] {
+	i, _ := n.search(char)
+	next := &Node[V]{}
+	n.children = slices.Insert(n.children, i, child[V]{char: char, node: next})
+	return next
+}
+
+// removeChild unlinks the child for the rune, if there is one
+func (n *Node[V]) removeChild(char rune) {
+	if i, ok := n.search(char); ok {
+		n.children = slices.Delete(n.children, i, i+1)
+	}
+}
+
+// search returns the position of the rune among the children, or where it would be inserted
+func (n *Node[V]) search(char rune) (int, bool) {
+	return slices.BinarySearchFunc(n.children, char, func(c child[V], char rune) int {
+		return int(c.char - char)
+	})
 }
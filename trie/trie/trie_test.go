@@ -2,7 +2,9 @@ package trie
 
 import (
 	"reflect"
+	"slices"
 	"sort"
+	"strings"
 	"testing"
 )
 
@@ -215,3 +217,209 @@ func TestListWords(t *testing.T) {
 		t.Errorf("ListWords returned %v after deletion, expected %v", actual, expected)
 	}
 }
+
+// TestAll tests iterating over the words in the Trie
+func TestAll(t *testing.T) {
+	tests := []struct {
+		name  string
+		words []string // Words to insert
+		stop  int      // Number of words to take before breaking, 0 for all
+		want  []string // Expected words produced by the iterator
+	}{
+		{name: "Empty trie", words: []string{}, want: nil},
+		{name: "Sorted output", words: []string{"car", "apple", "cat", "app"}, want: []string{"app", "apple", "car", "cat"}},
+		{name: "Early break", words: []string{"car", "apple", "cat", "app"}, stop: 2, want: []string{"app", "apple"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trie := InitTrie()
+			for _, word := range tt.words {
+				trie.Insert(word)
+			}
+
+			var got []string
+			for word := range trie.All() {
+				got = append(got, word)
+				if len(got) == tt.stop {
+					break
+				}
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("All() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestUnicodeWords tests words outside the lowercase English alphabet
+func TestUnicodeWords(t *testing.T) {
+	myTrie := InitTrie()
+
+	words := []string{"Hello", "hello", "user-42", "naïve", "日本語", "日本", "🚀launch", "A"}
+	for _, word := range words {
+		myTrie.Insert(word)
+	}
+
+	for _, word := range words {
+		if !myTrie.Search(word) {
+			t.Errorf("Search failed for word that should be in trie: %q", word)
+		}
+	}
+
+	tests := []struct {
+		word       string
+		wantSearch bool
+		wantPrefix bool
+	}{
+		{"HELLO", false, false}, // Case-sensitive by default
+		{"Hell", false, true},
+		{"user-", false, true},
+		{"user-4", false, true},
+		{"naï", false, true},
+		{"nai", false, false},
+		{"日", false, true},
+		{"🚀", false, true},
+		{"🚁", false, false},
+	}
+	for _, tt := range tests {
+		if got := myTrie.Search(tt.word); got != tt.wantSearch {
+			t.Errorf("Search(%q) = %v, want %v", tt.word, got, tt.wantSearch)
+		}
+		if got := myTrie.StartsWith(tt.word); got != tt.wantPrefix {
+			t.Errorf("StartsWith(%q) = %v, want %v", tt.word, got, tt.wantPrefix)
+		}
+	}
+
+	// Words come out in code point order, which is also the byte order of their UTF-8 encoding
+	want := slices.Clone(words)
+	slices.Sort(want)
+	if got := myTrie.ListWords(); !slices.Equal(got, want) {
+		t.Errorf("ListWords() = %q, want %q", got, want)
+	}
+	if got := slices.Collect(myTrie.All()); !slices.Equal(got, want) {
+		t.Errorf("All() = %q, want %q", got, want)
+	}
+
+	// Deleting a word removes the nodes only it used, but keeps shared prefixes
+	if !myTrie.Delete("日本語") {
+		t.Errorf("Delete failed for word that exists: %q", "日本語")
+	}
+	if myTrie.Search("日本語") || !myTrie.Search("日本") {
+		t.Errorf("Delete(%q) removed the wrong words: %q", "日本語", myTrie.ListWords())
+	}
+	if n := myTrie.find("日本"); n == nil || len(n.children) != 0 {
+		t.Errorf("Node for %q still has children after deleting its only extension", "日本")
+	}
+}
+
+// TestSortedChildren tests that children stay sorted and unique whatever the insertion order
+func TestSortedChildren(t *testing.T) {
+	myTrie := InitTrie()
+	for _, word := range []string{"z", "a", "m", "Z", "é", "0", "a", "m"} {
+		myTrie.Insert(word)
+	}
+	myTrie.Delete("m")
+
+	var chars []rune
+	for _, c := range myTrie.root.children {
+		chars = append(chars, c.char)
+	}
+	if want := []rune{'0', 'Z', 'a', 'z', 'é'}; !slices.Equal(chars, want) {
+		t.Errorf("Root children = %q, want %q", chars, want)
+	}
+}
+
+// TestCaseFolding tests the WithCaseFolding option
+func TestCaseFolding(t *testing.T) {
+	myTrie := New[struct{}](WithCaseFolding())
+	for _, word := range []string{"Hello", "GoLang", "ÉCOLE", "Straße"} {
+		myTrie.Insert(word)
+	}
+
+	tests := []struct {
+		word string
+		want bool
+	}{
+		{"hello", true},
+		{"HELLO", true},
+		{"hElLo", true},
+		{"golang", true},
+		{"école", true},
+		{"École", true},
+		{"STRAßE", true},
+		{"\u212Aelvin", false}, // The Kelvin sign folds to k, but "kelvin" was never inserted
+	}
+	for _, tt := range tests {
+		if got := myTrie.Search(tt.word); got != tt.want {
+			t.Errorf("Search(%q) = %v, want %v", tt.word, got, tt.want)
+		}
+	}
+	if !myTrie.StartsWith("GOL") {
+		t.Errorf("StartsWith(%q) = false, want true", "GOL")
+	}
+
+	// Different spellings of the same word are one entry, listed in folded form
+	myTrie.Insert("HELLO")
+	myTrie.Insert("\u212Aelvin")
+	if !myTrie.Search("kelvin") {
+		t.Errorf("Search(%q) after inserting it with a Kelvin sign = false, want true", "kelvin")
+	}
+	want := []string{"golang", "hello", "kelvin", "straße", "école"}
+	if got := myTrie.ListWords(); !slices.Equal(got, want) {
+		t.Errorf("ListWords() = %q, want %q", got, want)
+	}
+
+	if !myTrie.Delete("GOLANG") || myTrie.Search("golang") {
+		t.Errorf("Delete(%q) did not remove %q", "GOLANG", "golang")
+	}
+}
+
+// TestNormalizer tests the WithNormalizer option
+func TestNormalizer(t *testing.T) {
+	const (
+		composed   = "caf\u00e9"  // "é" as a single code point
+		decomposed = "cafe\u0301" // "e" followed by a combining acute accent
+	)
+	// A stand-in for Unicode NFC that composes just "e" followed by a combining acute accent
+	composeAcute := func(s string) string {
+		return strings.ReplaceAll(s, "e\u0301", "\u00e9")
+	}
+
+	myTrie := New[struct{}](WithNormalizer(composeAcute))
+	myTrie.Insert(decomposed)
+	if !myTrie.Search(composed) || !myTrie.Search(decomposed) {
+		t.Errorf("Search for both forms of a normalized word = (%v, %v), want (true, true)",
+			myTrie.Search(composed), myTrie.Search(decomposed))
+	}
+	if got := myTrie.ListWords(); !slices.Equal(got, []string{composed}) {
+		t.Errorf("ListWords() = %q, want the normalized form [%q]", got, composed)
+	}
+
+	// Normalization runs before case folding
+	folding := New[struct{}](WithNormalizer(composeAcute), WithCaseFolding())
+	folding.Insert("Cafe\u0301")
+	if !folding.Search("CAF\u00c9") {
+		t.Errorf("Search(%q) with normalization and case folding = false, want true", "CAF\u00c9")
+	}
+
+	// Without the option the two forms are different words
+	plain := InitTrie()
+	plain.Insert(decomposed)
+	if plain.Search(composed) {
+		t.Errorf("Search(%q) without normalization = true, want false", composed)
+	}
+}
+
+// TestInvalidUTF8 tests that invalid bytes do not panic and are stored as U+FFFD
+func TestInvalidUTF8(t *testing.T) {
+	myTrie := InitTrie()
+	myTrie.Insert("ab\xffc")
+	if !myTrie.Search("ab�c") {
+		t.Errorf("Search with U+FFFD in place of an invalid byte = false, want true")
+	}
+	if got := myTrie.ListWords(); !slices.Equal(got, []string{"ab�c"}) {
+		t.Errorf("ListWords() = %q, want [\"ab\\uFFFDc\"]", got)
+	}
+}